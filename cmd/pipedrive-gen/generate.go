@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/SocietyOne/pipedrive-api/pipedrive"
+)
+
+// Schema holds the field definitions of each entity, as returned by the
+// dealFields, personFields, ... endpoints.
+type Schema map[pipedrive.FieldEntity][]pipedrive.Field
+
+// Options configures the generated code.
+type Options struct {
+	// Package is the package name of the generated file.
+	Package string
+
+	// Entities to generate structs for. Defaults to all entities of the schema.
+	Entities []pipedrive.FieldEntity
+
+	// Named tags custom fields with their names instead of their keys.
+	Named bool
+}
+
+// baseObjects are the base structs embedded by the generated structs.
+var baseObjects = map[pipedrive.FieldEntity]reflect.Type{
+	pipedrive.FieldEntityDeal:         reflect.TypeOf(pipedrive.BaseDealObject{}),
+	pipedrive.FieldEntityPerson:       reflect.TypeOf(pipedrive.BasePersonObject{}),
+	pipedrive.FieldEntityOrganization: reflect.TypeOf(pipedrive.BaseOrganizationObject{}),
+	pipedrive.FieldEntityProduct:      reflect.TypeOf(pipedrive.BaseProductObject{}),
+	pipedrive.FieldEntityActivity:     reflect.TypeOf(pipedrive.BaseActivityObject{}),
+	pipedrive.FieldEntityNote:         reflect.TypeOf(pipedrive.BaseNoteObject{}),
+}
+
+// Generate returns the formatted Go source of the entity structs.
+func Generate(schema Schema, opt Options) ([]byte, error) {
+	entities := opt.Entities
+	if len(entities) == 0 {
+		for entity := range schema {
+			entities = append(entities, entity)
+		}
+		sort.Slice(entities, func(i, j int) bool { return entities[i] < entities[j] })
+	}
+
+	body := &bytes.Buffer{}
+	for _, entity := range entities {
+		fields, ok := schema[entity]
+		if !ok {
+			return nil, fmt.Errorf("schema has no %s fields", entity)
+		}
+
+		g := &generator{entity: entity, named: opt.Named, names: map[string]bool{}}
+		g.generate(body, fields)
+	}
+
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "// Code generated by pipedrive-gen. DO NOT EDIT.\n\npackage %s\n\n", opt.Package)
+	if bytes.Contains(body.Bytes(), []byte("pipedrive.")) {
+		fmt.Fprintf(src, "import \"github.com/SocietyOne/pipedrive-api/pipedrive\"\n\n")
+	}
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+
+	return formatted, nil
+}
+
+// generator writes the struct of a single entity.
+type generator struct {
+	entity pipedrive.FieldEntity
+	named  bool
+
+	// names holds the identifiers used by the entity's declarations.
+	names map[string]bool
+
+	// ambiguous holds the field names that don't resolve to a single field,
+	// because several fields share them or they're the key of another field.
+	ambiguous map[string]bool
+
+	fields []string
+	types  bytes.Buffer
+}
+
+func (g *generator) generate(w *bytes.Buffer, fields []pipedrive.Field) {
+	name := exportedName(string(g.entity))
+	base, embedded := baseObjects[g.entity]
+
+	// Custom fields must not shadow the fields and methods of the base struct.
+	if embedded {
+		for _, promoted := range promotedNames(base) {
+			g.names[promoted] = true
+		}
+	} else {
+		g.names["ID"] = true
+	}
+
+	g.ambiguous = ambiguousNames(fields)
+	for _, f := range fields {
+		if f.Custom() {
+			g.field(name, f)
+		}
+	}
+
+	fmt.Fprintf(w, "// %s is a Pipedrive %s with the custom fields of the company.\n", name, g.entity)
+	fmt.Fprintf(w, "type %s struct {\n", name)
+	if embedded {
+		fmt.Fprintf(w, "pipedrive.%s\n\n", base.Name())
+	} else {
+		fmt.Fprintf(w, "ID int `json:\"id,omitempty\"`\n\n")
+	}
+	fmt.Fprintf(w, "// Custom Fields\n")
+	for _, line := range g.fields {
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	w.Write(g.types.Bytes())
+}
+
+// field adds the struct fields of a custom field.
+func (g *generator) field(entityName string, f pipedrive.Field) {
+	ident := g.unique(exportedName(f.Name), "Field")
+
+	switch f.FieldType {
+	case pipedrive.FieldTypeDouble:
+		g.add(ident, "*float64", f, "")
+
+	case pipedrive.FieldTypeMonetary:
+		g.add(ident, "*float64", f, "")
+		g.add(g.unique(ident+"Currency", ""), "*string", f, "currency")
+
+	case pipedrive.FieldTypeDate:
+		g.add(ident, "*pipedrive.Date", f, "")
+
+	case pipedrive.FieldTypeDaterange:
+		g.add(ident, "*pipedrive.Date", f, "")
+		g.add(g.unique(ident+"Until", ""), "*pipedrive.Date", f, "until")
+
+	case pipedrive.FieldTypeTime:
+		g.add(ident, "*pipedrive.TimeOfDay", f, "")
+
+	case pipedrive.FieldTypeTimerange:
+		g.add(ident, "*pipedrive.TimeOfDay", f, "")
+		g.add(g.unique(ident+"Until", ""), "*pipedrive.TimeOfDay", f, "until")
+
+	case pipedrive.FieldTypeUser:
+		g.add(ident, "*pipedrive.UserID", f, "")
+
+	case pipedrive.FieldTypeOrg:
+		g.add(ident, "*pipedrive.OrgID", f, "")
+
+	case pipedrive.FieldTypePeople:
+		g.add(ident, "*pipedrive.PersonID", f, "")
+
+	case pipedrive.FieldTypeEnum, pipedrive.FieldTypeSet:
+		typeName := g.unique(entityName+ident, "Option")
+		g.options(typeName, f)
+
+		switch {
+		case f.FieldType == pipedrive.FieldTypeEnum:
+			g.add(ident, "*"+typeName, f, "")
+		case g.named:
+			g.add(ident, "[]"+typeName, f, "")
+		default:
+			g.add(ident, "pipedrive.OptionIDs", f, "")
+		}
+
+	case pipedrive.FieldTypeVarchar, pipedrive.FieldTypeVarcharAuto, pipedrive.FieldTypeText,
+		pipedrive.FieldTypePhone, pipedrive.FieldTypeAddress:
+		g.add(ident, "*string", f, "")
+
+	default:
+		g.add(ident, "interface{}", f, "")
+	}
+}
+
+// add adds a struct field holding the value of f, or of its subfield suffix.
+func (g *generator) add(ident, typ string, f pipedrive.Field, suffix string) {
+	var tag string
+
+	if g.named {
+		// Names with commas can't be used in tags, and names shared with
+		// other fields, such as a custom "Email" field of a person, resolve
+		// to the system field; the key works as well.
+		name := f.Name
+		if strings.Contains(name, ",") || g.ambiguous[name] {
+			name = f.Key
+		}
+
+		tag = "json:\"-\" pipedrive:\"field=" + name
+		if suffix != "" {
+			tag += ",suffix=" + suffix
+		}
+		tag += ",omitempty\""
+	} else {
+		key := f.Key
+		if suffix != "" {
+			key += "_" + suffix
+		}
+		tag = "json:\"" + key + ",omitempty\""
+	}
+
+	g.fields = append(g.fields, fmt.Sprintf("%s %s `%s` // %s", ident, typ, tag, fieldComment(f, suffix)))
+}
+
+// promotedNames returns the names of the exported fields and methods that a
+// struct embedding t gets from it, including t's own name.
+func promotedNames(t reflect.Type) []string {
+	names := []string{t.Name()}
+
+	for _, f := range reflect.VisibleFields(t) {
+		if f.IsExported() {
+			names = append(names, f.Name)
+		}
+	}
+
+	methods := reflect.PointerTo(t)
+	for i := 0; i < methods.NumMethod(); i++ {
+		names = append(names, methods.Method(i).Name)
+	}
+
+	return names
+}
+
+// ambiguousNames returns the names of fields that a FieldSet can't resolve
+// to the field itself.
+func ambiguousNames(fields []pipedrive.Field) map[string]bool {
+	seen := make(map[string]bool, len(fields))
+	keys := make(map[string]string, len(fields))
+	for _, f := range fields {
+		keys[f.Key] = f.Name
+	}
+
+	ambiguous := map[string]bool{}
+	for _, f := range fields {
+		if seen[f.Name] {
+			ambiguous[f.Name] = true
+		}
+		seen[f.Name] = true
+
+		if name, ok := keys[f.Name]; ok && name != f.Name {
+			ambiguous[f.Name] = true
+		}
+	}
+
+	return ambiguous
+}
+
+// options declares the option type and constants of an enum or set field.
+// Options are identified by label when named, and by ID otherwise.
+func (g *generator) options(typeName string, f pipedrive.Field) {
+	fmt.Fprintf(&g.types, "// %s is an option of the %q %s field.\n", typeName, f.Name, g.entity)
+
+	if g.named {
+		fmt.Fprintf(&g.types, "type %s string\n\n", typeName)
+	} else {
+		fmt.Fprintf(&g.types, "type %s = pipedrive.OptionID\n\n", typeName)
+	}
+
+	if len(f.Options) == 0 {
+		return
+	}
+
+	fmt.Fprintf(&g.types, "const (\n")
+	for _, o := range f.Options {
+		name := g.unique(typeName+exportedName(o.Label), "")
+
+		if g.named {
+			fmt.Fprintf(&g.types, "%s %s = %q\n", name, typeName, o.Label)
+			continue
+		}
+
+		if _, err := strconv.Atoi(o.ID); err != nil {
+			continue
+		}
+		fmt.Fprintf(&g.types, "%s %s = %s // %s\n", name, typeName, o.ID, o.Label)
+	}
+	fmt.Fprintf(&g.types, ")\n\n")
+}
+
+// unique returns ident, or ident with a numeric suffix if it's taken. An
+// empty ident is replaced by fallback.
+func (g *generator) unique(ident, fallback string) string {
+	if ident == "" {
+		ident = fallback
+	}
+
+	name := ident
+	for i := 2; g.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", ident, i)
+	}
+
+	g.names[name] = true
+
+	return name
+}
+
+func fieldComment(f pipedrive.Field, suffix string) string {
+	if suffix != "" {
+		return fmt.Sprintf("%s (%s)", f.Name, suffix)
+	}
+
+	return fmt.Sprintf("%s (%s)", f.Name, f.FieldType)
+}
+
+// exportedName converts a field name or option label such as "Loan amount"
+// into an exported Go identifier such as "LoanAmount".
+func exportedName(s string) string {
+	var b strings.Builder
+
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	name := b.String()
+	if name == "" {
+		return ""
+	}
+
+	if first := []rune(name)[0]; !unicode.IsUpper(first) {
+		name = "Field" + name
+	}
+
+	return name
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/SocietyOne/pipedrive-api/pipedrive"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `{
+	"deal": [
+		{"id":1,"key":"title","name":"Title","field_type":"varchar","edit_flag":false},
+		{"id":2,"key":"f68bc64c61ed5be74939265930336b9424d7c39b","name":"Loan Amount","field_type":"monetary","edit_flag":true},
+		{"id":3,"key":"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678","name":"Loan purpose","field_type":"enum","edit_flag":true,"options":[{"id":12,"label":"Car"},{"id":13,"label":"Home loan"}]},
+		{"id":4,"key":"0123456789abcdef0123456789abcdef01234567","name":"Channels","field_type":"set","edit_flag":true,"options":[{"id":1,"label":"Web"},{"id":2,"label":"Phone"}]},
+		{"id":5,"key":"fedcba9876543210fedcba9876543210fedcba98","name":"Settlement date","field_type":"date","edit_flag":true},
+		{"id":6,"key":"00000000000000000000000000000000000000aa","name":"Broker","field_type":"user","edit_flag":true}
+	],
+	"organization": [
+		{"id":7,"key":"name","name":"Name","field_type":"varchar","edit_flag":false},
+		{"id":8,"key":"11111111111111111111111111111111111111bb","name":"ABN","field_type":"varchar","edit_flag":true}
+	]
+}`
+
+func TestGenerate(t *testing.T) {
+	schema := Schema{}
+	if err := json.Unmarshal([]byte(testSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Test keyed fields", func(t *testing.T) {
+		src, err := Generate(schema, Options{Package: "crm"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := string(src)
+		assert.Contains(t, code, "// Code generated by pipedrive-gen. DO NOT EDIT.\n\npackage crm\n")
+		assert.Contains(t, code, "type Deal struct {\n\tpipedrive.BaseDealObject\n")
+		assert.Contains(t, code, "LoanAmount         *float64")
+		assert.Contains(t, code, "`json:\"f68bc64c61ed5be74939265930336b9424d7c39b,omitempty\"`")
+		assert.Contains(t, code, "// Loan Amount (monetary)")
+		assert.Contains(t, code, "LoanAmountCurrency *string")
+		assert.Contains(t, code, "`json:\"f68bc64c61ed5be74939265930336b9424d7c39b_currency,omitempty\"`")
+		assert.Contains(t, code, "LoanPurpose        *DealLoanPurpose")
+		assert.Contains(t, code, "Channels           pipedrive.OptionIDs")
+		assert.Contains(t, code, "SettlementDate     *pipedrive.Date")
+		assert.Contains(t, code, "Broker             *pipedrive.UserID")
+		assert.Contains(t, code, "type DealLoanPurpose = pipedrive.OptionID")
+		assert.Contains(t, code, "DealLoanPurposeHomeLoan DealLoanPurpose = 13 // Home loan")
+		assert.NotContains(t, code, "Title")
+
+		assert.Contains(t, code, "type Organization struct {\n\tpipedrive.BaseOrganizationObject\n")
+		assert.Contains(t, code, "ABN *string `json:\"11111111111111111111111111111111111111bb,omitempty\"`")
+	})
+
+	t.Run("Test named fields", func(t *testing.T) {
+		src, err := Generate(schema, Options{Package: "crm", Entities: []pipedrive.FieldEntity{pipedrive.FieldEntityDeal}, Named: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := string(src)
+		assert.Contains(t, code, "`json:\"-\" pipedrive:\"field=Loan Amount,suffix=currency,omitempty\"`")
+		assert.Contains(t, code, "type DealLoanPurpose string")
+		assert.Contains(t, code, "DealLoanPurposeCar      DealLoanPurpose = \"Car\"")
+		assert.Contains(t, code, "Channels           []DealChannels")
+		assert.NotContains(t, code, "Organization")
+	})
+
+	t.Run("Test named fields sharing a name", func(t *testing.T) {
+		schema := Schema{}
+		err := json.Unmarshal([]byte(`{"person": [
+			{"id":1,"key":"email","name":"Email","field_type":"varchar","edit_flag":false},
+			{"id":2,"key":"22222222222222222222222222222222222222cc","name":"Email","field_type":"varchar","edit_flag":true},
+			{"id":3,"key":"33333333333333333333333333333333333333dd","name":"Referrer","field_type":"varchar","edit_flag":true}
+		]}`), &schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src, err := Generate(schema, Options{Package: "crm", Named: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := string(src)
+		assert.Contains(t, code, "Email2   *string `json:\"-\" pipedrive:\"field=22222222222222222222222222222222222222cc,omitempty\"`")
+		assert.Contains(t, code, "Referrer *string `json:\"-\" pipedrive:\"field=Referrer,omitempty\"`")
+	})
+
+	t.Run("Test fields named like base fields", func(t *testing.T) {
+		schema := Schema{}
+		err := json.Unmarshal([]byte(`{
+			"deal": [{"id":1,"key":"44444444444444444444444444444444444444ee","name":"Value","field_type":"user","edit_flag":true}],
+			"activity": [{"id":2,"key":"55555555555555555555555555555555555555ff","name":"Type","field_type":"varchar","edit_flag":true}]
+		}`), &schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src, err := Generate(schema, Options{Package: "crm"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := string(src)
+		assert.Contains(t, code, "Value2 *pipedrive.UserID")
+		assert.Contains(t, code, "Type2 *string")
+
+		// The base fields must still be reachable through the generated structs.
+		assertCompiles(t, src, `package crm
+
+import "github.com/SocietyOne/pipedrive-api/pipedrive"
+
+var (
+	_ *pipedrive.Nullable[float64] = Deal{}.Value
+	_ *pipedrive.UserID            = Deal{}.Value2
+	_ *string                      = Activity{}.Type
+	_ *string                      = Activity{}.Type2
+)
+`)
+	})
+
+	t.Run("Test missing entity", func(t *testing.T) {
+		_, err := Generate(schema, Options{Package: "crm", Entities: []pipedrive.FieldEntity{pipedrive.FieldEntityProduct}})
+		assert.EqualError(t, err, "schema has no product fields")
+	})
+
+	t.Run("Test exported names", func(t *testing.T) {
+		assert.Equal(t, "LoanAmount", exportedName("loan amount"))
+		assert.Equal(t, "Field2ndApplicant", exportedName("2nd applicant"))
+		assert.Equal(t, "EMail", exportedName("e-mail"))
+		assert.Equal(t, "", exportedName("???"))
+	})
+}
+
+// assertCompiles builds the generated code along with check, a file of the
+// same package.
+func assertCompiles(t *testing.T, generated []byte, check string) {
+	t.Helper()
+
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	// Build inside the module, so the pipedrive package resolves. Directories
+	// starting with _ are ignored by ./... patterns.
+	dir, err := ioutil.TempDir(".", "_gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "entities_gen.go"), generated, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "check.go"), []byte(check), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := exec.Command(gobin, "build", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated code doesn't compile: %v\n%s", err, out)
+	}
+}
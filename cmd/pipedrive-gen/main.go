@@ -0,0 +1,139 @@
+// Command pipedrive-gen generates Go structs for the entities of a Pipedrive
+// company, with typed fields for its custom fields.
+//
+// The field definitions are fetched from the API, or read from a schema file
+// previously saved with -dump:
+//
+//	pipedrive-gen -api-key $PIPEDRIVE_API_KEY -dump schema.json -package crm -out entities_gen.go
+//	pipedrive-gen -schema schema.json -package crm -out entities_gen.go
+//
+// It's meant to be run with go generate:
+//
+//	//go:generate go run github.com/SocietyOne/pipedrive-api/cmd/pipedrive-gen -schema schema.json -package crm -out entities_gen.go
+//
+// By default the generated fields are tagged with the custom field keys of
+// the company. With -named they are tagged with the field names instead, so
+// the same code works against every company that has the fields; such
+// structs must be encoded and decoded with a pipedrive.FieldSet.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/SocietyOne/pipedrive-api/pipedrive"
+)
+
+func main() {
+	var (
+		apiKey        = flag.String("api-key", os.Getenv("PIPEDRIVE_API_KEY"), "API token used to fetch the field definitions. Defaults to $PIPEDRIVE_API_KEY")
+		companyDomain = flag.String("company-domain", os.Getenv("PIPEDRIVE_COMPANY_DOMAIN"), "Company domain to fetch the field definitions from. Defaults to $PIPEDRIVE_COMPANY_DOMAIN")
+		schemaFile    = flag.String("schema", "", "Read the field definitions from this file instead of the API")
+		dumpFile      = flag.String("dump", "", "Save the fetched field definitions to this file")
+		entities      = flag.String("entities", "deal,person,organization,product,activity", "Comma-separated entities to generate")
+		pkg           = flag.String("package", "main", "Package name of the generated file")
+		out           = flag.String("out", "", "Output file. Defaults to stdout")
+		named         = flag.Bool("named", false, "Tag custom fields with their names instead of their keys")
+	)
+	flag.Parse()
+
+	if err := run(*apiKey, *companyDomain, *schemaFile, *dumpFile, *entities, *pkg, *out, *named); err != nil {
+		fmt.Fprintln(os.Stderr, "pipedrive-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(apiKey, companyDomain, schemaFile, dumpFile, entities, pkg, out string, named bool) error {
+	var wanted []pipedrive.FieldEntity
+	for _, e := range strings.Split(entities, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			wanted = append(wanted, pipedrive.FieldEntity(e))
+		}
+	}
+
+	var (
+		schema Schema
+		err    error
+	)
+
+	if schemaFile != "" {
+		schema, err = readSchema(schemaFile)
+	} else {
+		schema, err = fetchSchema(apiKey, companyDomain, wanted)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dumpFile != "" {
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(dumpFile, data, 0644); err != nil {
+			return err
+		}
+	}
+
+	src, err := Generate(schema, Options{Package: pkg, Entities: wanted, Named: named})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+
+	return ioutil.WriteFile(out, src, 0644)
+}
+
+func readSchema(path string) (Schema, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := Schema{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return schema, nil
+}
+
+func fetchSchema(apiKey, companyDomain string, entities []pipedrive.FieldEntity) (Schema, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("either -schema or -api-key is required")
+	}
+
+	client := pipedrive.NewClient(&pipedrive.Config{
+		APIKey:        apiKey,
+		BaseURL:       pipedrive.DefaultBaseURL,
+		CompanyDomain: companyDomain,
+		Timeout:       30 * time.Second,
+		RetryPolicy:   pipedrive.NewRetryPolicy(),
+		RateLimitMode: pipedrive.RateLimitWait,
+	})
+
+	ctx := context.Background()
+	schema := Schema{}
+
+	for _, entity := range entities {
+		fields, err := pipedrive.IterateFields(client, entity, nil).All(ctx, 0)
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s fields: %v", entity, err)
+		}
+
+		schema[entity] = fields
+	}
+
+	return schema, nil
+}
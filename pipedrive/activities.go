@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
-// Pipedrive API dcos: https://developers.pipedrive.com/docs/api/v1/#!/Deals
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities
 
 //go:generate moq -out mock_activity.go . Activity
 
@@ -15,29 +16,63 @@ import (
 type Activity interface {
 }
 
-// BaseActivityObject represents a basic Pipedrive deal.
+// ActivityParticipant is a person participating in an activity.
+type ActivityParticipant struct {
+	PersonID    int  `json:"person_id"`
+	PrimaryFlag bool `json:"primary_flag"`
+}
+
+// ActivityAttendee is a guest of an activity that is synced to a calendar.
+type ActivityAttendee struct {
+	EmailAddress string `json:"email_address"`
+	Name         string `json:"name,omitempty"`
+	PersonID     int    `json:"person_id,omitempty"`
+	UserID       int    `json:"user_id,omitempty"`
+	Status       string `json:"status,omitempty"`
+	IsOrganizer  int    `json:"is_organizer,omitempty"`
+}
+
+// BaseActivityObject represents a basic Pipedrive activity.
+// Nil fields are left unchanged by updates; see Nullable.
 type BaseActivityObject struct {
 	// Unsettable Fields
-	ID int `json:"id,omitempty"`
+	ID               int       `json:"id,omitempty"`
+	CompanyID        int       `json:"company_id,omitempty"`
+	ActiveFlag       bool      `json:"active_flag,omitempty"`
+	AddTime          *DateTime `json:"add_time,omitempty"`
+	UpdateTime       *DateTime `json:"update_time,omitempty"`
+	MarkedAsDoneTime *DateTime `json:"marked_as_done_time,omitempty"`
+	CreatedByUserID  int       `json:"created_by_user_id,omitempty"`
+	DealTitle        string    `json:"deal_title,omitempty"`
+	PersonName       string    `json:"person_name,omitempty"`
+	OrgName          string    `json:"org_name,omitempty"`
+	OwnerName        string    `json:"owner_name,omitempty"`
 
 	// Settable Fields
-	Subject *string `json:"subject,omitempty"` // Subject of the activity
-	// Done *bool `json:"done,omitempty"`
-	Type              *string `json:"type,omitempty"`               // Type of the activity. This is in correlation with the key_string parameter of ActivityTypes.
-	DueDate           *string `json:"due_date,omitempty"`           // Due date of the activity. Format: YYYY-MM-DD
-	DueTime           *string `json:"due_time,omitempty"`           // Due time of the activity in UTC. Format: HH:MM
-	Duration          *string `json:"duration,omitempty"`           // Duration of the activity. Format: HH:MM
-	UserID            *int    `json:"user_id,omitempty"`            // ID of the user whom the activity will be assigned to. If omitted, the activity will be assigned to the authorized user.
-	DealID            *int    `json:"deal_id,omitempty"`            // ID of the deal this activity will be associated with
-	PersonID          *int    `json:"person_id,omitempty"`          // ID of the person this activity will be associated with
-	OrgID             *int    `json:"org_id,omitempty"`             // ID of the organization this activity will be associated with
-	Note              *string `json:"note,omitempty"`               // Note of the activity (HTML format)
-	PublicDescription *string `json:"public_description,omitempty"` // Additional details about the activity that will be synced to your external calendar. Unlike the note added to the activity, the description will be publicly visible to any guests added to the activity.
+	Subject           *string               `json:"subject,omitempty"`            // Subject of the activity
+	Done              *bool                 `json:"done,omitempty"`               // Whether the activity is done or not
+	BusyFlag          *Nullable[bool]       `json:"busy_flag,omitempty"`          // Whether the activity marks the assignee as busy in their synced calendar. Null leaves it to the calendar.
+	Location          *string               `json:"location,omitempty"`           // The address of the activity. Pipedrive parses it into location_* fields
+	Participants      []ActivityParticipant `json:"participants,omitempty"`       // Persons participating in the activity. Exactly one should be primary
+	Attendees         []ActivityAttendee    `json:"attendees,omitempty"`          // Guests of the activity in the synced calendar
+	Type              *string               `json:"type,omitempty"`               // Type of the activity. This is in correlation with the key_string parameter of ActivityTypes.
+	DueDate           *Date                 `json:"due_date,omitempty"`           // Due date of the activity. Format: YYYY-MM-DD
+	DueTime           *TimeOfDay            `json:"due_time,omitempty"`           // Due time of the activity in UTC. Format: HH:MM
+	Duration          *Duration             `json:"duration,omitempty"`           // Duration of the activity. Format: HH:MM
+	UserID            *int                  `json:"user_id,omitempty"`            // ID of the user whom the activity will be assigned to. If omitted, the activity will be assigned to the authorized user.
+	DealID            *Nullable[int]        `json:"deal_id,omitempty"`            // ID of the deal this activity will be associated with
+	PersonID          *Nullable[int]        `json:"person_id,omitempty"`          // ID of the person this activity will be associated with
+	OrgID             *Nullable[int]        `json:"org_id,omitempty"`             // ID of the organization this activity will be associated with
+	Note              *string               `json:"note,omitempty"`               // Note of the activity (HTML format)
+	PublicDescription *string               `json:"public_description,omitempty"` // Additional details about the activity that will be synced to your external calendar. Unlike the note added to the activity, the description will be publicly visible to any guests added to the activity.
 }
 
 // CreateActivity creates an activity .
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/post_activities
 func (c *Client) CreateActivity(ctx context.Context, activity Activity, out ResponseModel) error {
+	if err := c.validateActivity(ctx, activity); err != nil {
+		return err
+	}
 
 	req, err := c.NewRequest(http.MethodPost, "/activities", nil, activity)
 	if err != nil {
@@ -49,8 +84,203 @@ func (c *Client) CreateActivity(ctx context.Context, activity Activity, out Resp
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// CreateActivity creates an activity and returns it decoded into T.
+func CreateActivity[T Activity](ctx context.Context, c *Client, activity Activity) (T, *Response, error) {
+	if err := c.validateActivity(ctx, activity); err != nil {
+		var zero T
+		return zero, nil, err
+	}
+
+	return doData[T](ctx, c, http.MethodPost, "/activities", nil, activity)
+}
+
+// Overdue reports whether the activity isn't done and its due date, or due
+// time if it has one, is before now. Activities without a due date are never
+// overdue.
+func (a *BaseActivityObject) Overdue(now time.Time) bool {
+	if a.Done != nil && *a.Done || a.DueDate == nil || a.DueDate.IsZero() {
+		return false
+	}
+
+	now = now.UTC()
+
+	if a.DueTime == nil || a.DueTime.IsZero() {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		return a.DueDate.Before(today)
+	}
+
+	due := a.DueDate.Add(time.Duration(a.DueTime.Hour)*time.Hour + time.Duration(a.DueTime.Minute)*time.Minute)
+
+	return now.After(due)
+}
+
+// activityType returns the Type checked by WithActivityTypeValidation.
+func (a BaseActivityObject) activityType() *string {
+	return a.Type
+}
+
+// GetActivity gets an activity by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities_id
+func (c *Client) GetActivity(ctx context.Context, id int, out ResponseModel) error {
+	uri := fmt.Sprintf("/activities/%v", id)
+	req, err := c.NewRequest(http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// UpdateActivity updates an activity.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/put_activities_id
+func (c *Client) UpdateActivity(ctx context.Context, id int, activity Activity, out ResponseModel) error {
+	if err := c.validateActivity(ctx, activity); err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("/activities/%v", id)
+	req, err := c.NewRequest(http.MethodPut, uri, nil, activity)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// MarkActivityDone marks an activity as done.
+func (c *Client) MarkActivityDone(ctx context.Context, id int, out ResponseModel) error {
+	done := true
+	return c.UpdateActivity(ctx, id, &BaseActivityObject{Done: &done}, out)
+}
+
+// MarkActivityUndone marks an activity as not done.
+func (c *Client) MarkActivityUndone(ctx context.Context, id int, out ResponseModel) error {
+	done := false
+	return c.UpdateActivity(ctx, id, &BaseActivityObject{Done: &done}, out)
+}
+
+// DeleteActivity marks an activity as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/delete_activities_id
+func (c *Client) DeleteActivity(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/activities/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+	return nil
+}
+
+// DeleteActivities marks multiple activities as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/delete_activities
+func (c *Client) DeleteActivities(ctx context.Context, ids []int) error {
+	req, err := c.NewRequest(http.MethodDelete, "/activities", &DeleteMultipleOptions{
+		Ids: arrayToString(ids, ","),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
 	}
 
 	return nil
 }
+
+// ListActivitiesOptions is used to configure a list activities request.
+type ListActivitiesOptions struct {
+	UserID    *int    `url:"user_id,omitempty"`    // ID of the user whose activities will be fetched. If omitted, the user associated with the API token will be used. If 0, activities for all company users will be fetched based on the permission sets
+	FilterID  *int    `url:"filter_id,omitempty"`  // ID of the filter to use. Will be ignored if user_id is set
+	Type      *string `url:"type,omitempty"`       // Type of the activity, can be one type or multiple types separated by a comma. This is in correlation with the key_string parameter of ActivityTypes
+	StartDate *Date   `url:"start_date,omitempty"` // Date in format of YYYY-MM-DD from which activities to fetch from
+	EndDate   *Date   `url:"end_date,omitempty"`   // Date in format of YYYY-MM-DD until which activities to fetch to
+	Done      *bool   `url:"done,omitempty,int"`   // Whether the activity is done or not. If omitted returns both done and not done activities
+	Start     *int    `url:"start,omitempty"`      // Pagination start
+	Limit     *int    `url:"limit,omitempty"`      // Items shown per page
+}
+
+// ListActivities lists activities assigned to a user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities
+func (c *Client) ListActivities(ctx context.Context, opt *ListActivitiesOptions, out ResponseModel) error {
+	req, err := c.NewRequest(http.MethodGet, "/activities", opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// GetActivity gets an activity by ID, decoded into T.
+func GetActivity[T Activity](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/activities/%v", id), nil, nil)
+}
+
+// UpdateActivity updates an activity and returns it decoded into T.
+func UpdateActivity[T Activity](ctx context.Context, c *Client, id int, activity Activity) (T, *Response, error) {
+	if err := c.validateActivity(ctx, activity); err != nil {
+		var zero T
+		return zero, nil, err
+	}
+
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/activities/%v", id), nil, activity)
+}
+
+// ListActivities lists activities assigned to a user, decoded into T.
+func ListActivities[T Activity](ctx context.Context, c *Client, opt *ListActivitiesOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, "/activities", opt)
+}
+
+// IterateActivities returns an Iterator over activities, decoded into T.
+func IterateActivities[T Activity](c *Client, opt *ListActivitiesOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, "/activities", &o.Start, o)
+}
@@ -2,9 +2,11 @@ package pipedrive
 
 import (
 	"context"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,3 +56,91 @@ func TestCreateActivity(t *testing.T) {
 		}
 	})
 }
+
+func TestActivities(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/activities/57735":
+			w.Write([]byte(`{"success":true,"data":{"id":57735,"user_id":11535881,"done":false,"type":"call","due_date":"2020-06-29","due_time":"09:30","duration":"00:30","busy_flag":true,"subject":"test subject","location":"1 Main St","person_id":11140,"deal_id":16143,"participants":[{"person_id":11140,"primary_flag":true}],"attendees":[{"email_address":"jane@example.com","name":"Jane","status":"accepted"}]}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/activities/57735":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"done":true}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":57735,"done":true,"marked_as_done_time":"2020-06-30 01:00:00"}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/activities/57735":
+			w.Write([]byte(`{"success":true,"data":{"id":57735}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/activities":
+			q := req.URL.Query()
+			assert.Equal(t, "0", q.Get("user_id"))
+			assert.Equal(t, "call,meeting", q.Get("type"))
+			assert.Equal(t, "2020-06-01", q.Get("start_date"))
+			assert.Equal(t, "2020-06-30", q.Get("end_date"))
+			assert.Equal(t, "0", q.Get("done"))
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"subject":"Overdue","done":false,"due_date":"2020-06-29"},{"id":2,"subject":"Later today","done":false,"due_date":"2020-06-30","due_time":"23:00"}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test get activity", func(t *testing.T) {
+		activity, _, err := GetActivity[BaseActivityObject](context.Background(), testClient, 57735)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.False(t, *activity.Done)
+		assert.Equal(t, "1 Main St", *activity.Location)
+		busy, _ := activity.BusyFlag.Get()
+		assert.True(t, busy)
+		assert.Equal(t, []ActivityParticipant{{PersonID: 11140, PrimaryFlag: true}}, activity.Participants)
+		assert.Equal(t, "jane@example.com", activity.Attendees[0].EmailAddress)
+		assert.Equal(t, "09:30", activity.DueTime.String())
+	})
+
+	t.Run("Test mark activity done", func(t *testing.T) {
+		outActivity := &BaseActivityObject{}
+		err := testClient.MarkActivityDone(context.Background(), 57735, &BaseResponse{Data: outActivity})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.True(t, *outActivity.Done)
+		assert.Equal(t, 2020, outActivity.MarkedAsDoneTime.Year())
+	})
+
+	t.Run("Test delete activity", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteActivity(context.Background(), 57735))
+	})
+
+	t.Run("Test find overdue activities", func(t *testing.T) {
+		userID := 0
+		types := "call,meeting"
+		done := false
+
+		activities, err := IterateActivities[BaseActivityObject](testClient, &ListActivitiesOptions{
+			UserID:    &userID,
+			Type:      &types,
+			StartDate: NewDate(2020, time.June, 1),
+			EndDate:   NewDate(2020, time.June, 30),
+			Done:      &done,
+		}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		now := time.Date(2020, time.June, 30, 12, 0, 0, 0, time.UTC)
+		if assert.Len(t, activities, 2) {
+			assert.True(t, activities[0].Overdue(now))
+			assert.False(t, activities[1].Overdue(now))
+			assert.True(t, activities[1].Overdue(now.Add(12*time.Hour)))
+		}
+	})
+}
@@ -0,0 +1,336 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes
+
+// ActivityIcon is the icon key of an activity type.
+type ActivityIcon string
+
+const (
+	ActivityIconTask         ActivityIcon = "task"
+	ActivityIconEmail        ActivityIcon = "email"
+	ActivityIconMeeting      ActivityIcon = "meeting"
+	ActivityIconDeadline     ActivityIcon = "deadline"
+	ActivityIconCall         ActivityIcon = "call"
+	ActivityIconLunch        ActivityIcon = "lunch"
+	ActivityIconCalendar     ActivityIcon = "calendar"
+	ActivityIconDownArrow    ActivityIcon = "downarrow"
+	ActivityIconDocument     ActivityIcon = "document"
+	ActivityIconSmartphone   ActivityIcon = "smartphone"
+	ActivityIconCamera       ActivityIcon = "camera"
+	ActivityIconScissors     ActivityIcon = "scissors"
+	ActivityIconCogs         ActivityIcon = "cogs"
+	ActivityIconBubble       ActivityIcon = "bubble"
+	ActivityIconUpArrow      ActivityIcon = "uparrow"
+	ActivityIconCheckbox     ActivityIcon = "checkbox"
+	ActivityIconSignpost     ActivityIcon = "signpost"
+	ActivityIconShuffle      ActivityIcon = "shuffle"
+	ActivityIconAddressBook  ActivityIcon = "addressbook"
+	ActivityIconLineGraph    ActivityIcon = "linegraph"
+	ActivityIconPicture      ActivityIcon = "picture"
+	ActivityIconCar          ActivityIcon = "car"
+	ActivityIconWorld        ActivityIcon = "world"
+	ActivityIconSearch       ActivityIcon = "search"
+	ActivityIconClip         ActivityIcon = "clip"
+	ActivityIconSound        ActivityIcon = "sound"
+	ActivityIconBrush        ActivityIcon = "brush"
+	ActivityIconKey          ActivityIcon = "key"
+	ActivityIconPadlock      ActivityIcon = "padlock"
+	ActivityIconPriceTag     ActivityIcon = "pricetag"
+	ActivityIconSuitcase     ActivityIcon = "suitcase"
+	ActivityIconFinish       ActivityIcon = "finish"
+	ActivityIconPlane        ActivityIcon = "plane"
+	ActivityIconLoop         ActivityIcon = "loop"
+	ActivityIconWifi         ActivityIcon = "wifi"
+	ActivityIconTruck        ActivityIcon = "truck"
+	ActivityIconCart         ActivityIcon = "cart"
+	ActivityIconBulb         ActivityIcon = "bulb"
+	ActivityIconBell         ActivityIcon = "bell"
+	ActivityIconPresentation ActivityIcon = "presentation"
+)
+
+// ActivityType is a type of activity, such as a call or a meeting. The
+// Type of an activity is the KeyString of its ActivityType.
+type ActivityType struct {
+	// Unsettable Fields
+	ID           int       `json:"id,omitempty"`
+	KeyString    string    `json:"key_string,omitempty"`
+	ActiveFlag   bool      `json:"active_flag,omitempty"`
+	IsCustomFlag bool      `json:"is_custom_flag,omitempty"`
+	AddTime      *DateTime `json:"add_time,omitempty"`
+	UpdateTime   *DateTime `json:"update_time,omitempty"`
+
+	// Settable Fields
+	Name    string       `json:"name,omitempty"`     // Required when creating
+	IconKey ActivityIcon `json:"icon_key,omitempty"` // Required when creating
+	Color   string       `json:"color,omitempty"`    // A designated color for the activity type in 6-character HEX format (e.g. FFFFFF for white)
+	OrderNr int          `json:"order_nr,omitempty"` // An order number for this activity type. Order numbers should be used to order the types in the activity type selections
+}
+
+// ListActivityTypes lists all activity types.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/get_activityTypes
+func (c *Client) ListActivityTypes(ctx context.Context) ([]ActivityType, error) {
+	types, _, err := doData[[]ActivityType](ctx, c, http.MethodGet, "/activityTypes", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.activityTypes.set(types)
+
+	return types, nil
+}
+
+// CreateActivityType creates an activity type. Name and IconKey are required.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/post_activityTypes
+func (c *Client) CreateActivityType(ctx context.Context, activityType *ActivityType) (*ActivityType, error) {
+	created, _, err := doData[*ActivityType](ctx, c, http.MethodPost, "/activityTypes", nil, activityType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.activityTypes.reset()
+
+	return created, nil
+}
+
+// UpdateActivityType updates an activity type.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/put_activityTypes_id
+func (c *Client) UpdateActivityType(ctx context.Context, id int, activityType *ActivityType) (*ActivityType, error) {
+	uri := fmt.Sprintf("/activityTypes/%v", id)
+	updated, _, err := doData[*ActivityType](ctx, c, http.MethodPut, uri, nil, activityType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.activityTypes.reset()
+
+	return updated, nil
+}
+
+// ReorderActivityTypes sets the order of activity types to the order of ids.
+// Activity types that aren't listed keep their order number.
+//
+// Each activity type is updated with its own request, so reordering isn't
+// atomic: if an update fails, the activity types updated before it keep
+// their new order. The IDs of the updated activity types are returned, also
+// on error.
+func (c *Client) ReorderActivityTypes(ctx context.Context, ids []int) ([]int, error) {
+	updated := make([]int, 0, len(ids))
+
+	for i, id := range ids {
+		if _, err := c.UpdateActivityType(ctx, id, &ActivityType{OrderNr: i + 1}); err != nil {
+			return updated, err
+		}
+		updated = append(updated, id)
+	}
+
+	return updated, nil
+}
+
+// DeleteActivityType marks an activity type as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/delete_activityTypes_id
+func (c *Client) DeleteActivityType(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/activityTypes/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	c.activityTypes.reset()
+
+	return nil
+}
+
+// DeleteActivityTypes marks multiple activity types as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/delete_activityTypes
+func (c *Client) DeleteActivityTypes(ctx context.Context, ids []int) error {
+	req, err := c.NewRequest(http.MethodDelete, "/activityTypes", &DeleteMultipleOptions{
+		Ids: arrayToString(ids, ","),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	c.activityTypes.reset()
+
+	return nil
+}
+
+// UnknownActivityTypeError is returned when an activity's Type doesn't
+// match the key string of an active activity type. It matches ErrValidation.
+type UnknownActivityTypeError struct {
+	Type  string
+	Known []string
+}
+
+func (e *UnknownActivityTypeError) Error() string {
+	return fmt.Sprintf("pipedrive: unknown activity type %q, expected one of %s", e.Type, strings.Join(e.Known, ", "))
+}
+
+// Is matches ErrValidation, as the API would reject the activity.
+func (e *UnknownActivityTypeError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ValidateActivityType checks that keyString is the key string of an active
+// activity type. Activity types are cached by the client. On a miss they're
+// fetched again in case keyString was added since, at most once every
+// activityTypeRefetchInterval, so repeated unknown types don't cost a request
+// each.
+func (c *Client) ValidateActivityType(ctx context.Context, keyString string) error {
+	return c.activityTypes.validate(ctx, c, keyString)
+}
+
+// ResetActivityTypes drops the cached activity types.
+func (c *Client) ResetActivityTypes() {
+	c.activityTypes.reset()
+}
+
+// validateActivity checks the Type of an activity if activity type
+// validation is enabled. Activities bound to a FieldSet are checked too.
+func (c *Client) validateActivity(ctx context.Context, activity Activity) error {
+	if !c.validateActivityTypes {
+		return nil
+	}
+
+	if b, ok := activity.(*FieldBinding); ok {
+		activity = b.Value()
+	}
+
+	typed, ok := activity.(interface{ activityType() *string })
+	if !ok {
+		return nil
+	}
+
+	if t := typed.activityType(); t != nil {
+		return c.ValidateActivityType(ctx, *t)
+	}
+
+	return nil
+}
+
+// activityTypeRefetchInterval is the minimum time between fetches of the
+// activity types caused by unknown types.
+const activityTypeRefetchInterval = time.Minute
+
+// activityTypeCache caches the key strings of the active activity types.
+// Activity types are fetched without holding mu, so validations of known
+// types don't wait for a fetch, and waiting callers can give up with their
+// context.
+type activityTypeCache struct {
+	mu       sync.Mutex
+	keys     []string // In the order of the activity types
+	active   map[string]bool
+	fetched  time.Time     // Zero if the activity types aren't cached
+	fetching chan struct{} // Closed when the fetch in flight is done; nil if there is none
+	gen      int           // Incremented by reset, so fetches in flight aren't cached
+}
+
+func (a *activityTypeCache) validate(ctx context.Context, c *Client, keyString string) error {
+	for {
+		a.mu.Lock()
+
+		if a.active[keyString] {
+			a.mu.Unlock()
+			return nil
+		}
+
+		if wait := a.fetching; wait != nil {
+			a.mu.Unlock()
+
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if !a.fetched.IsZero() && time.Since(a.fetched) < activityTypeRefetchInterval {
+			err := &UnknownActivityTypeError{Type: keyString, Known: append([]string(nil), a.keys...)}
+			a.mu.Unlock()
+			return err
+		}
+
+		done := make(chan struct{})
+		a.fetching = done
+		gen := a.gen
+		a.mu.Unlock()
+
+		types, _, err := doData[[]ActivityType](ctx, c, http.MethodGet, "/activityTypes", nil, nil)
+
+		a.mu.Lock()
+		if err == nil && gen == a.gen {
+			a.store(types)
+		}
+		a.fetching = nil
+		close(done)
+		a.mu.Unlock()
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (a *activityTypeCache) set(types []ActivityType) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.store(types)
+}
+
+// store caches types. The caller must hold a.mu.
+func (a *activityTypeCache) store(types []ActivityType) {
+	a.keys = nil
+	a.active = make(map[string]bool, len(types))
+	a.fetched = time.Now()
+
+	for _, t := range types {
+		if t.ActiveFlag {
+			a.keys = append(a.keys, t.KeyString)
+			a.active[t.KeyString] = true
+		}
+	}
+}
+
+func (a *activityTypeCache) reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.keys = nil
+	a.active = nil
+	a.fetched = time.Time{}
+	a.gen++
+}
@@ -0,0 +1,231 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityTypes(t *testing.T) {
+	var listed, created int
+	var withDemo bool
+	var listRequested, releaseList chan struct{}
+
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/activityTypes":
+			listed++
+			if releaseList != nil {
+				listRequested <- struct{}{}
+				<-releaseList
+			}
+			if withDemo {
+				w.Write([]byte(`{"success":true,"data":[{"id":1,"order_nr":1,"name":"Call","key_string":"call","icon_key":"call","active_flag":true},{"id":3,"order_nr":2,"name":"Demo","key_string":"demo","icon_key":"presentation","active_flag":true}]}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"order_nr":1,"name":"Call","key_string":"call","icon_key":"call","active_flag":true,"color":null,"is_custom_flag":false,"add_time":"2020-06-01 02:41:35","update_time":null},{"id":2,"order_nr":2,"name":"Fax","key_string":"fax","icon_key":"document","active_flag":false,"is_custom_flag":true}]}`))
+
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/activityTypes":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Demo","icon_key":"presentation","color":"FFFFFF"}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":3,"order_nr":3,"name":"Demo","key_string":"demo","icon_key":"presentation","active_flag":true,"color":"FFFFFF","is_custom_flag":true}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/activityTypes/3":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"order_nr":1}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":3,"order_nr":1}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/activityTypes/1":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"order_nr":2}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":1,"order_nr":2}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/activityTypes/4":
+			w.WriteHeader(404)
+			w.Write([]byte(`{"success":false,"error":"Activity type not found"}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/activityTypes":
+			assert.Equal(t, "2,3", req.URL.Query().Get("ids"))
+			w.Write([]byte(`{"success":true,"data":{"id":[2,3]}}`))
+
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/activities":
+			created++
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":57735,"type":"call"}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+	if err := testClient.SetOptions(WithActivityTypeValidation(true)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Test list activity types", func(t *testing.T) {
+		types, err := testClient.ListActivityTypes(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, types, 2) {
+			assert.Equal(t, "call", types[0].KeyString)
+			assert.Equal(t, ActivityIconCall, types[0].IconKey)
+			assert.Equal(t, 2020, types[0].AddTime.Year())
+			assert.False(t, types[1].ActiveFlag)
+		}
+	})
+
+	t.Run("Test create activity type", func(t *testing.T) {
+		activityType, err := testClient.CreateActivityType(context.Background(), &ActivityType{
+			Name:    "Demo",
+			IconKey: ActivityIconPresentation,
+			Color:   "FFFFFF",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 3, activityType.ID)
+		assert.Equal(t, "demo", activityType.KeyString)
+	})
+
+	t.Run("Test reorder activity types", func(t *testing.T) {
+		updated, err := testClient.ReorderActivityTypes(context.Background(), []int{3, 1})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{3, 1}, updated)
+
+		// Reordering isn't atomic; the updates before the failed one stay.
+		updated, err = testClient.ReorderActivityTypes(context.Background(), []int{3, 4, 1})
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Equal(t, []int{3}, updated)
+	})
+
+	t.Run("Test delete activity types", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteActivityTypes(context.Background(), []int{2, 3}))
+	})
+
+	t.Run("Test create activity with known type", func(t *testing.T) {
+		testClient.ResetActivityTypes()
+		listed, created = 0, 0
+
+		activityType := "call"
+		for i := 0; i < 2; i++ {
+			_, _, err := CreateActivity[BaseActivityObject](context.Background(), testClient, &BaseActivityObject{Type: &activityType})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		assert.Equal(t, 1, listed)
+		assert.Equal(t, 2, created)
+	})
+
+	t.Run("Test create activity with unknown type", func(t *testing.T) {
+		listed, created = 0, 0
+
+		for _, activityType := range []string{"cal", "fax"} {
+			activityType := activityType
+			err := testClient.CreateActivity(context.Background(), BaseActivityObject{Type: &activityType}, &BaseResponse{})
+
+			var unknown *UnknownActivityTypeError
+			if assert.True(t, errors.As(err, &unknown)) {
+				assert.Equal(t, activityType, unknown.Type)
+				assert.Equal(t, []string{"call"}, unknown.Known)
+			}
+			assert.True(t, errors.Is(err, ErrValidation))
+		}
+
+		// The cached types were just fetched, so misses don't fetch them again.
+		assert.Equal(t, 0, listed)
+		assert.Equal(t, 0, created)
+	})
+
+	t.Run("Test create activity with new type", func(t *testing.T) {
+		withDemo = true
+		defer func() { withDemo = false }()
+		listed, created = 0, 0
+
+		assert.True(t, errors.Is(testClient.ValidateActivityType(context.Background(), "demo"), ErrValidation))
+		assert.Equal(t, 0, listed)
+
+		// Once the cached types are stale, a miss fetches them again and
+		// keeps the result, including for later misses.
+		testClient.activityTypes.fetched = time.Now().Add(-activityTypeRefetchInterval)
+
+		assert.Nil(t, testClient.ValidateActivityType(context.Background(), "demo"))
+		assert.Nil(t, testClient.ValidateActivityType(context.Background(), "demo"))
+
+		err := testClient.ValidateActivityType(context.Background(), "cal")
+		var unknown *UnknownActivityTypeError
+		if assert.True(t, errors.As(err, &unknown)) {
+			assert.Equal(t, []string{"call", "demo"}, unknown.Known)
+		}
+		assert.Equal(t, 1, listed)
+	})
+
+	t.Run("Test slow activity type fetch", func(t *testing.T) {
+		listRequested, releaseList = make(chan struct{}), make(chan struct{})
+		defer func() { listRequested, releaseList = nil, nil }()
+		listed = 0
+
+		testClient.activityTypes.fetched = time.Now().Add(-activityTypeRefetchInterval)
+
+		validated := make(chan error)
+		go func() {
+			validated <- testClient.ValidateActivityType(context.Background(), "fax")
+		}()
+		<-listRequested
+
+		// Known types don't wait for the fetch.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.Nil(t, testClient.ValidateActivityType(ctx, "call"))
+
+		// Other misses wait for it, and give up with their context.
+		ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, context.DeadlineExceeded, testClient.ValidateActivityType(ctx, "meeting"))
+
+		close(releaseList)
+		assert.True(t, errors.Is(<-validated, ErrValidation))
+		assert.Equal(t, 1, listed)
+	})
+
+	t.Run("Test create bound activity with unknown type", func(t *testing.T) {
+		listed, created = 0, 0
+
+		fields := NewFieldSet(FieldEntityActivity, nil)
+		activityType := "cal"
+		err := testClient.CreateActivity(context.Background(), fields.Bind(&BaseActivityObject{Type: &activityType}), &BaseResponse{})
+
+		var unknown *UnknownActivityTypeError
+		if assert.True(t, errors.As(err, &unknown)) {
+			assert.Equal(t, "cal", unknown.Type)
+		}
+		assert.Equal(t, 0, created)
+	})
+
+	t.Run("Test create activity without validation", func(t *testing.T) {
+		if err := testClient.SetOptions(WithActivityTypeValidation(false)); err != nil {
+			t.Fatal(err)
+		}
+		listed, created = 0, 0
+
+		activityType := "cal"
+		assert.Nil(t, testClient.CreateActivity(context.Background(), &BaseActivityObject{Type: &activityType}, &BaseResponse{}))
+		assert.Equal(t, 0, listed)
+		assert.Equal(t, 1, created)
+	})
+}
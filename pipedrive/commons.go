@@ -1,7 +1,11 @@
 package pipedrive
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -13,6 +17,7 @@ type Pagination struct {
 	Start                 int  `json:"start"`
 	Limit                 int  `json:"limit"`
 	MoreItemsInCollection bool `json:"more_items_in_collection"`
+	NextStart             int  `json:"next_start,omitempty"`
 }
 
 type AdditionalData struct {
@@ -45,7 +50,8 @@ type AdditionalData struct {
 }
 
 // ResponseModel is the response model
-// Should use the BaseResponse with expected struct as BaseResponse.Data.
+// Should use the BaseResponse with expected struct as BaseResponse.Data,
+// or a typed DataResponse[T].
 type ResponseModel interface {
 	Successful() bool
 	ErrorString() string
@@ -58,8 +64,8 @@ type BaseResponse struct {
 	Error     string      `json:"error,omitempty"`
 	ErrorInfo string      `json:"error_info,omitempty"`
 
-	AdditionalData AdditionalData `json:"additional_data,omitempty"`
-	RelatedObjects interface{}    `json:"related_objects,omitempty"`
+	AdditionalData AdditionalData  `json:"additional_data,omitempty"`
+	RelatedObjects *RelatedObjects `json:"related_objects,omitempty"`
 }
 
 func (b *BaseResponse) Successful() bool {
@@ -134,6 +140,7 @@ const (
 	FieldTypeTime        FieldType = "time"
 	FieldTypeTimerange   FieldType = "timerange"
 	FieldTypeDaterange   FieldType = "daterange"
+	FieldTypeAddress     FieldType = "address"
 )
 
 // Visiblity
@@ -144,6 +151,18 @@ const (
 	VisibleToEntireCompany   VisibleTo = 3
 )
 
+//UnmarshalJSON accepts visible_to as a number or a numeric string, as returned by v1 endpoints
+func (v *VisibleTo) UnmarshalJSON(data []byte) error {
+	id, err := parseReferenceID(data)
+	if err != nil {
+		return fmt.Errorf("pipedrive: visible_to: %v", err)
+	}
+
+	*v = VisibleTo(id)
+
+	return nil
+}
+
 // Deal probability
 type DealProbability uint8
 
@@ -157,6 +176,10 @@ type SearchOptions struct {
 	Term string `url:"term,omitempty"`
 }
 
+// OrgID, UserID and PersonID reference other objects. Depending on the
+// endpoint, the API returns them as an ID, a numeric string, an object or
+// null; all are decoded. A reference with a zero ID is sent as null, which
+// clears it.
 type OrgID struct {
 	// Settable Fields
 	ID int `json:"value,omitempty"`
@@ -172,13 +195,33 @@ type OrgID struct {
 
 //MarshalJSON is a Marshalling override
 func (o *OrgID) MarshalJSON() ([]byte, error) {
-	format := fmt.Sprintf("\"%d\"", o.ID)
-	return []byte(format), nil
+	if o == nil {
+		return []byte("null"), nil
+	}
+	return marshalReference(o.ID), nil
+}
+
+//UnmarshalJSON is an Unmarshalling override
+func (o *OrgID) UnmarshalJSON(data []byte) error {
+	type orgID OrgID
+	ref := orgID{}
+
+	id, err := unmarshalReference(data, &ref)
+	if err != nil {
+		return fmt.Errorf("pipedrive: org_id: %v", err)
+	}
+
+	*o = OrgID(ref)
+	o.ID = id
+
+	return nil
 }
 
 type UserID struct {
 	// Settable Fields
-	ID      int `json:"id,omitempty"`
+	ID int `json:"id,omitempty"`
+
+	// Deprecated: IDValue holds the same ID as ID when decoded, use ID.
 	IDValue int `json:"value,omitempty"`
 
 	// Unsettable Fields
@@ -189,8 +232,30 @@ type UserID struct {
 
 //MarshalJSON is a Marshalling override
 func (u *UserID) MarshalJSON() ([]byte, error) {
-	format := fmt.Sprintf("\"%d\"", u.ID)
-	return []byte(format), nil
+	if u == nil {
+		return []byte("null"), nil
+	}
+	if u.ID == 0 {
+		return marshalReference(u.IDValue), nil
+	}
+	return marshalReference(u.ID), nil
+}
+
+//UnmarshalJSON is an Unmarshalling override
+func (u *UserID) UnmarshalJSON(data []byte) error {
+	type userID UserID
+	ref := userID{}
+
+	id, err := unmarshalReference(data, &ref)
+	if err != nil {
+		return fmt.Errorf("pipedrive: user_id: %v", err)
+	}
+
+	*u = UserID(ref)
+	u.ID = id
+	u.IDValue = id
+
+	return nil
 }
 
 type PersonID struct {
@@ -206,6 +271,77 @@ type PersonID struct {
 
 //MarshalJSON is a Marshalling override
 func (p *PersonID) MarshalJSON() ([]byte, error) {
-	format := fmt.Sprintf("\"%d\"", p.ID)
-	return []byte(format), nil
+	if p == nil {
+		return []byte("null"), nil
+	}
+	return marshalReference(p.ID), nil
+}
+
+//UnmarshalJSON is an Unmarshalling override
+func (p *PersonID) UnmarshalJSON(data []byte) error {
+	type personID PersonID
+	ref := personID{}
+
+	id, err := unmarshalReference(data, &ref)
+	if err != nil {
+		return fmt.Errorf("pipedrive: person_id: %v", err)
+	}
+
+	*p = PersonID(ref)
+	p.ID = id
+
+	return nil
+}
+
+// marshalReference encodes the ID of a reference, or null if it's zero.
+func marshalReference(id int) []byte {
+	if id == 0 {
+		return []byte("null")
+	}
+
+	return []byte(fmt.Sprintf("\"%d\"", id))
+}
+
+// unmarshalReference decodes a reference given as an ID, a numeric string,
+// an object or null. Objects are decoded into object as well. The ID of an
+// object is read from its value field, or from its id field.
+func unmarshalReference(data []byte, object interface{}) (int, error) {
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 || data[0] != '{' {
+		return parseReferenceID(data)
+	}
+
+	if err := json.Unmarshal(data, object); err != nil {
+		return 0, err
+	}
+
+	var ids struct {
+		Value json.RawMessage `json:"value"`
+		ID    json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return 0, err
+	}
+
+	if id, err := parseReferenceID(ids.Value); err == nil && id != 0 {
+		return id, nil
+	}
+
+	return parseReferenceID(ids.ID)
+}
+
+// parseReferenceID parses an ID given as a number, a numeric string or null.
+func parseReferenceID(data []byte) (int, error) {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reference %s", data)
+	}
+
+	return id, nil
 }
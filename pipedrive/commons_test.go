@@ -0,0 +1,68 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferences(t *testing.T) {
+	t.Run("Test decoding expanded objects", func(t *testing.T) {
+		deal := &BaseDealObject{}
+		err := json.Unmarshal([]byte(`{
+			"user_id":{"id":11535881,"name":"Tom Shi","email":"tom.shi@societyone.com.au","has_pic":0,"active_flag":true,"value":11535881},
+			"person_id":{"active_flag":true,"name":"testtest","email":[{"value":"","primary":true}],"phone":[{"value":"","primary":true}],"value":3},
+			"org_id":{"name":"Acme","people_count":1,"owner_id":11535881,"address":null,"active_flag":true,"cc_email":"acme@example.com","value":1}
+		}`), deal)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 11535881, deal.UserID.ID)
+		assert.Equal(t, 11535881, deal.UserID.IDValue)
+		assert.Equal(t, "Tom Shi", deal.UserID.Name)
+		assert.Equal(t, 3, deal.PersonID.Value.ID)
+		assert.Equal(t, "testtest", deal.PersonID.Value.Name)
+		assert.Equal(t, 1, deal.OrgID.Value.ID)
+		assert.Equal(t, "Acme", deal.OrgID.Value.Name)
+	})
+
+	t.Run("Test decoding IDs", func(t *testing.T) {
+		deal := &BaseDealObject{}
+		err := json.Unmarshal([]byte(`{"user_id":11535881,"person_id":"3","org_id":null}`), deal)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 11535881, deal.UserID.ID)
+		assert.Equal(t, 3, deal.PersonID.Value.ID)
+		assert.Nil(t, deal.OrgID)
+	})
+
+	t.Run("Test decoding objects with an id", func(t *testing.T) {
+		user := UserID{}
+		assert.Nil(t, json.Unmarshal([]byte(`{"id":7,"name":"Jane"}`), &user))
+		assert.Equal(t, 7, user.ID)
+
+		org := OrgID{ID: 9}
+		assert.Nil(t, json.Unmarshal([]byte(`null`), &org))
+		assert.Equal(t, 0, org.ID)
+	})
+
+	t.Run("Test invalid reference", func(t *testing.T) {
+		person := PersonID{}
+		assert.EqualError(t, json.Unmarshal([]byte(`"abc"`), &person), `pipedrive: person_id: invalid reference "abc"`)
+	})
+
+	t.Run("Test marshalling", func(t *testing.T) {
+		var org *OrgID
+		data, err := org.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, "null", string(data))
+
+		data, err = json.Marshal(&BaseDealObject{UserID: &UserID{IDValue: 5}, PersonID: NewNullable(PersonID{ID: 3})})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"user_id":"5","person_id":"3"}`, string(data))
+	})
+}
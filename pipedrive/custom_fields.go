@@ -0,0 +1,484 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Custom fields are keyed by a 40 character hash that differs between
+// companies. Struct fields tagged with
+//
+//	Amount   *float64 `json:"-" pipedrive:"field=Loan Amount"`
+//	Currency *string  `json:"-" pipedrive:"field=Loan Amount,suffix=currency"`
+//	Purpose  *string  `json:"-" pipedrive:"field=Loan Purpose,omitempty"`
+//
+// are mapped to their keys by a FieldSet, which resolves the field names
+// against the field definitions of the entity. The json:"-" tag keeps
+// encoding/json from handling the field under its Go name.
+//
+// Enum fields can be mapped to string fields and set fields to []string
+// fields, in which case option labels are used instead of option IDs.
+
+const tagCustomField = "pipedrive"
+
+// FieldSet maps the custom fields of an entity by name.
+type FieldSet struct {
+	Entity FieldEntity
+	Fields []Field
+
+	byKey  map[string]*Field
+	byName map[string]*Field
+}
+
+// NewFieldSet returns a FieldSet of the given field definitions.
+func NewFieldSet(entity FieldEntity, fields []Field) *FieldSet {
+	s := &FieldSet{
+		Entity: entity,
+		Fields: fields,
+		byKey:  make(map[string]*Field, len(fields)),
+		byName: make(map[string]*Field, len(fields)),
+	}
+
+	for i := range s.Fields {
+		f := &s.Fields[i]
+		s.byKey[f.Key] = f
+
+		// System fields come first, so they win over custom fields of the
+		// same name.
+		if _, ok := s.byName[f.Name]; !ok {
+			s.byName[f.Name] = f
+		}
+	}
+
+	return s
+}
+
+// Field returns the field with the given name or key. Names are matched
+// case-insensitively if there is no exact match.
+func (s *FieldSet) Field(name string) (*Field, bool) {
+	if f, ok := s.byKey[name]; ok {
+		return f, true
+	}
+
+	if f, ok := s.byName[name]; ok {
+		return f, true
+	}
+
+	for i := range s.Fields {
+		if strings.EqualFold(s.Fields[i].Name, name) {
+			return &s.Fields[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Key returns the key of the field with the given name.
+func (s *FieldSet) Key(name string) (string, bool) {
+	f, ok := s.Field(name)
+	if !ok {
+		return "", false
+	}
+
+	return f.Key, true
+}
+
+// Marshal encodes v as JSON, writing the tagged custom fields under their keys.
+func (s *FieldSet) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return data, nil
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return data, nil
+	}
+
+	tagged := customFieldsOf(rv.Type())
+	if len(tagged) == 0 {
+		return data, nil
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	for _, tf := range tagged {
+		fv, ok := fieldByIndex(rv, tf.index, false)
+		if !ok || (tf.omitEmpty && fv.IsZero()) {
+			continue
+		}
+
+		field, key, err := s.resolve(tf)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := encodeCustomField(field, tf, fv)
+		if err != nil {
+			return nil, err
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		m[key] = raw
+	}
+
+	return json.Marshal(m)
+}
+
+// Unmarshal decodes JSON into v, reading the tagged custom fields from their keys.
+func (s *FieldSet) Unmarshal(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	tagged := customFieldsOf(rv.Elem().Type())
+	if len(tagged) == 0 {
+		return nil
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		// Not an object, e.g. null.
+		return nil
+	}
+
+	for _, tf := range tagged {
+		field, key, err := s.resolve(tf)
+		if err != nil {
+			return err
+		}
+
+		raw, ok := m[key]
+		if !ok {
+			continue
+		}
+
+		fv, _ := fieldByIndex(rv.Elem(), tf.index, true)
+		if err := decodeCustomField(field, tf, raw, fv); err != nil {
+			return fmt.Errorf("pipedrive: %s field %q: %v", s.Entity, tf.name, err)
+		}
+	}
+
+	return nil
+}
+
+// Bind returns a value that encodes and decodes v with the FieldSet. It can
+// be used as the body of a request or as the Data of a BaseResponse.
+func (s *FieldSet) Bind(v interface{}) *FieldBinding {
+	return &FieldBinding{set: s, v: v}
+}
+
+// FieldBinding is a value bound to a FieldSet.
+type FieldBinding struct {
+	set *FieldSet
+	v   interface{}
+}
+
+// Value returns the bound value.
+func (b *FieldBinding) Value() interface{} {
+	return b.v
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *FieldBinding) MarshalJSON() ([]byte, error) {
+	return b.set.Marshal(b.v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *FieldBinding) UnmarshalJSON(data []byte) error {
+	return b.set.Unmarshal(data, b.v)
+}
+
+// resolve returns the field and key of a tagged struct field.
+func (s *FieldSet) resolve(tf customField) (*Field, string, error) {
+	field, ok := s.Field(tf.name)
+	if !ok {
+		return nil, "", fmt.Errorf("pipedrive: unknown %s field %q", s.Entity, tf.name)
+	}
+
+	if tf.suffix != "" {
+		return field, field.Key + "_" + tf.suffix, nil
+	}
+
+	return field, field.Key, nil
+}
+
+// FieldSet returns the FieldSet of an entity. Field definitions are fetched
+// on first use and cached by the client; call ResetFieldSets after changing
+// fields.
+func (c *Client) FieldSet(ctx context.Context, entity FieldEntity) (*FieldSet, error) {
+	return c.fieldSets.get(ctx, c, entity)
+}
+
+// ResetFieldSets drops the cached field definitions.
+func (c *Client) ResetFieldSets() {
+	c.fieldSets.reset()
+}
+
+// fieldSetCache caches the FieldSet of each entity. Field definitions are
+// fetched without holding mu, so a slow fetch only holds up callers that need
+// the same entity, and those can still give up with their context.
+type fieldSetCache struct {
+	mu       sync.Mutex
+	sets     map[FieldEntity]*FieldSet
+	fetching map[FieldEntity]chan struct{} // Closed when the fetch in flight is done
+	gen      int                           // Incremented by reset, so fetches in flight aren't cached
+}
+
+func (f *fieldSetCache) get(ctx context.Context, c *Client, entity FieldEntity) (*FieldSet, error) {
+	for {
+		f.mu.Lock()
+
+		if s, ok := f.sets[entity]; ok {
+			f.mu.Unlock()
+			return s, nil
+		}
+
+		if wait, ok := f.fetching[entity]; ok {
+			f.mu.Unlock()
+
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		done := make(chan struct{})
+		if f.fetching == nil {
+			f.fetching = map[FieldEntity]chan struct{}{}
+		}
+		f.fetching[entity] = done
+		gen := f.gen
+		f.mu.Unlock()
+
+		fields, err := IterateFields(c, entity, nil).All(ctx, 0)
+
+		var s *FieldSet
+		if err == nil {
+			s = NewFieldSet(entity, fields)
+		}
+
+		f.mu.Lock()
+		if s != nil && gen == f.gen {
+			if f.sets == nil {
+				f.sets = map[FieldEntity]*FieldSet{}
+			}
+			f.sets[entity] = s
+		}
+		delete(f.fetching, entity)
+		close(done)
+		f.mu.Unlock()
+
+		return s, err
+	}
+}
+
+func (f *fieldSetCache) reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sets = nil
+	f.gen++
+}
+
+// customField is a struct field tagged with a custom field name.
+type customField struct {
+	index     []int
+	name      string
+	suffix    string
+	omitEmpty bool
+}
+
+// customFieldsOf returns the tagged fields of a struct type, including those
+// of embedded structs.
+func customFieldsOf(t reflect.Type) []customField {
+	var fields []customField
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag, ok := sf.Tag.Lookup(tagCustomField)
+		if !ok {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			if sf.Anonymous && ft.Kind() == reflect.Struct {
+				for _, f := range customFieldsOf(ft) {
+					f.index = append([]int{i}, f.index...)
+					fields = append(fields, f)
+				}
+			}
+
+			continue
+		}
+
+		f := customField{index: []int{i}}
+		for _, part := range strings.Split(tag, ",") {
+			switch {
+			case strings.HasPrefix(part, "field="):
+				f.name = strings.TrimPrefix(part, "field=")
+			case strings.HasPrefix(part, "suffix="):
+				f.suffix = strings.TrimPrefix(part, "suffix=")
+			case part == "omitempty":
+				f.omitEmpty = true
+			}
+		}
+
+		if f.name != "" && sf.IsExported() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex that allocates nil embedded
+// pointers if alloc is set, and otherwise reports false when it meets one.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, true
+}
+
+// encodeCustomField returns the API value of a tagged struct field.
+func encodeCustomField(field *Field, tf customField, v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if tf.suffix != "" {
+		return v.Interface(), nil
+	}
+
+	switch {
+	case field.FieldType == FieldTypeEnum && v.Kind() == reflect.String:
+		if v.String() == "" {
+			return nil, nil
+		}
+
+		o, ok := field.Option(v.String())
+		if !ok {
+			return nil, fmt.Errorf("pipedrive: unknown option %q of field %q", v.String(), field.Name)
+		}
+
+		return optionValue(o.ID), nil
+
+	case field.FieldType == FieldTypeSet && isStringSlice(v):
+		ids := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			o, ok := field.Option(v.Index(i).String())
+			if !ok {
+				return nil, fmt.Errorf("pipedrive: unknown option %q of field %q", v.Index(i).String(), field.Name)
+			}
+
+			ids = append(ids, o.ID)
+		}
+
+		return strings.Join(ids, ","), nil
+	}
+
+	return v.Interface(), nil
+}
+
+// decodeCustomField decodes the API value of a custom field into a tagged struct field.
+func decodeCustomField(field *Field, tf customField, raw json.RawMessage, v reflect.Value) error {
+	target := v
+	if target.Kind() == reflect.Ptr {
+		target = reflect.New(v.Type().Elem()).Elem()
+	}
+
+	labels := tf.suffix == "" &&
+		((field.FieldType == FieldTypeEnum && target.Kind() == reflect.String) ||
+			(field.FieldType == FieldTypeSet && isStringSlice(target)))
+
+	if !labels {
+		return json.Unmarshal(raw, v.Addr().Interface())
+	}
+
+	value := strings.Trim(string(raw), `"`)
+	if value == "null" || value == "" {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	label := func(id string) string {
+		if o, ok := field.OptionByID(strings.TrimSpace(id)); ok {
+			return o.Label
+		}
+		return id
+	}
+
+	if target.Kind() == reflect.String {
+		target.SetString(label(value))
+	} else {
+		ids := strings.Split(value, ",")
+		target.Set(reflect.MakeSlice(target.Type(), len(ids), len(ids)))
+		for i, id := range ids {
+			target.Index(i).SetString(label(id))
+		}
+	}
+
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(target.Type())
+		ptr.Elem().Set(target)
+		v.Set(ptr)
+	}
+
+	return nil
+}
+
+// optionValue returns numeric option IDs as numbers.
+func optionValue(id string) interface{} {
+	if n, err := strconv.Atoi(id); err == nil {
+		return n
+	}
+
+	return id
+}
+
+func isStringSlice(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String
+}
@@ -0,0 +1,167 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type namedFieldsDeal struct {
+	BaseDealObject
+
+	LoanAmount   *float64 `json:"-" pipedrive:"field=Loan Amount,omitempty"`
+	LoanCurrency *string  `json:"-" pipedrive:"field=Loan Amount,suffix=currency,omitempty"`
+	Purpose      string   `json:"-" pipedrive:"field=loan purpose,omitempty"`
+	Channels     []string `json:"-" pipedrive:"field=Channels,omitempty"`
+}
+
+func TestCustomFields(t *testing.T) {
+	fieldRequests := 0
+	var personFieldRequests int32
+	personRequested := make(chan struct{}, 1)
+	releasePersonFields := make(chan struct{})
+
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.URL.Path == "/v1/dealFields":
+			fieldRequests++
+			w.Write([]byte(`{"success":true,"data":[` +
+				`{"id":1,"key":"title","name":"Title","field_type":"varchar","edit_flag":false},` +
+				`{"id":2,"key":"status","name":"Status","field_type":"status","edit_flag":false,"options":[{"id":"open","label":"Open"}]},` +
+				`{"id":3,"key":"f68bc64c61ed5be74939265930336b9424d7c39b","name":"Loan Amount","field_type":"monetary","edit_flag":true},` +
+				`{"id":4,"key":"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678","name":"Loan Purpose","field_type":"enum","edit_flag":true,"options":[{"id":12,"label":"Car"},{"id":13,"label":"Home"}]},` +
+				`{"id":5,"key":"0123456789abcdef0123456789abcdef01234567","name":"Channels","field_type":"set","edit_flag":true,"options":[{"id":1,"label":"Web"},{"id":2,"label":"Phone"},{"id":3,"label":"Branch"}]}` +
+				`],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.URL.Path == "/v1/personFields":
+			atomic.AddInt32(&personFieldRequests, 1)
+			personRequested <- struct{}{}
+			<-releasePersonFields
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"key":"name","name":"Name","field_type":"varchar","edit_flag":false}]}`))
+
+		case req.URL.Path == "/v1/organizationFields":
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"key":"name","name":"Name","field_type":"varchar","edit_flag":false}]}`))
+
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/deals":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"title":"Loan","f68bc64c61ed5be74939265930336b9424d7c39b":25000,"f68bc64c61ed5be74939265930336b9424d7c39b_currency":"AUD","a1b2c3d4e5f60718293a4b5c6d7e8f9012345678":13,"0123456789abcdef0123456789abcdef01234567":"1,3"}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":9,"title":"Loan","f68bc64c61ed5be74939265930336b9424d7c39b":25000,"f68bc64c61ed5be74939265930336b9424d7c39b_currency":"AUD","a1b2c3d4e5f60718293a4b5c6d7e8f9012345678":"13","0123456789abcdef0123456789abcdef01234567":"1,3"}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	fields, err := testClient.FieldSet(context.Background(), FieldEntityDeal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Test field lookup", func(t *testing.T) {
+		key, ok := fields.Key("Loan Amount")
+		assert.True(t, ok)
+		assert.Equal(t, "f68bc64c61ed5be74939265930336b9424d7c39b", key)
+
+		field, ok := fields.Field("f68bc64c61ed5be74939265930336b9424d7c39b")
+		if assert.True(t, ok) {
+			assert.True(t, field.Custom())
+		}
+
+		status, _ := fields.Field("Status")
+		assert.False(t, status.Custom())
+		assert.Equal(t, "open", status.Options[0].ID)
+
+		_, ok = fields.Field("Unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("Test create deal with named fields", func(t *testing.T) {
+		title := "Loan"
+		amount := 25000.0
+		currency := "AUD"
+
+		deal := &namedFieldsDeal{
+			BaseDealObject: BaseDealObject{Title: &title},
+			LoanAmount:     &amount,
+			LoanCurrency:   &currency,
+			Purpose:        "Home",
+			Channels:       []string{"Web", "Branch"},
+		}
+
+		outDeal := &namedFieldsDeal{}
+		err := testClient.CreateDeal(context.Background(), fields.Bind(deal), &BaseResponse{Data: fields.Bind(outDeal)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 9, outDeal.ID)
+		assert.Equal(t, 25000.0, *outDeal.LoanAmount)
+		assert.Equal(t, "AUD", *outDeal.LoanCurrency)
+		assert.Equal(t, "Home", outDeal.Purpose)
+		assert.Equal(t, []string{"Web", "Branch"}, outDeal.Channels)
+	})
+
+	t.Run("Test unknown option", func(t *testing.T) {
+		_, err := fields.Marshal(&namedFieldsDeal{Purpose: "Boat"})
+		assert.EqualError(t, err, `pipedrive: unknown option "Boat" of field "Loan Purpose"`)
+	})
+
+	t.Run("Test unknown field", func(t *testing.T) {
+		type unknownField struct {
+			Value string `json:"-" pipedrive:"field=Missing"`
+		}
+
+		_, err := fields.Marshal(&unknownField{})
+		assert.EqualError(t, err, `pipedrive: unknown deal field "Missing"`)
+	})
+
+	t.Run("Test field sets are cached", func(t *testing.T) {
+		_, err := testClient.FieldSet(context.Background(), FieldEntityDeal)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, fieldRequests)
+
+		testClient.ResetFieldSets()
+		_, err = testClient.FieldSet(context.Background(), FieldEntityDeal)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, fieldRequests)
+	})
+
+	t.Run("Test slow field fetch", func(t *testing.T) {
+		fetched := make(chan *FieldSet)
+		go func() {
+			s, _ := testClient.FieldSet(context.Background(), FieldEntityPerson)
+			fetched <- s
+		}()
+		<-personRequested
+
+		// Other entities don't wait for the person fields.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := testClient.FieldSet(ctx, FieldEntityOrganization)
+		assert.Nil(t, err)
+
+		// Callers waiting for the person fields give up with their context.
+		ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = testClient.FieldSet(ctx, FieldEntityPerson)
+		assert.Equal(t, context.DeadlineExceeded, err)
+
+		close(releasePersonFields)
+		assert.NotNil(t, <-fetched)
+
+		_, err = testClient.FieldSet(context.Background(), FieldEntityPerson)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&personFieldRequests))
+	})
+}
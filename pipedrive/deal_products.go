@@ -0,0 +1,129 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_products
+
+// DiscountType is how the discount of a deal product is applied.
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "percentage"
+	DiscountAmount     DiscountType = "amount"
+)
+
+// TaxMethod is how the tax of a deal product is applied.
+type TaxMethod string
+
+const (
+	TaxExclusive TaxMethod = "exclusive"
+	TaxInclusive TaxMethod = "inclusive"
+	TaxNone      TaxMethod = "none"
+)
+
+// DealProduct is a product attached to a deal, i.e. a line item.
+// Nil fields are left unchanged by updates; see Nullable.
+type DealProduct struct {
+	// Unsettable Fields
+	ID           int       `json:"id,omitempty"` // ID of the attachment, not of the product
+	DealID       int       `json:"deal_id,omitempty"`
+	OrderNr      int       `json:"order_nr,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Sum          float64   `json:"sum,omitempty"`
+	Currency     string    `json:"currency,omitempty"`
+	DurationUnit string    `json:"duration_unit,omitempty"`
+	ActiveFlag   bool      `json:"active_flag,omitempty"`
+	AddTime      *DateTime `json:"add_time,omitempty"`
+	LastEdit     *DateTime `json:"last_edit,omitempty"`
+
+	// Settable Fields
+	ProductID          *int           `json:"product_id,omitempty"`           // ID of the product. Required when attaching
+	ProductVariationID *Nullable[int] `json:"product_variation_id,omitempty"` // ID of the product variation
+	ItemPrice          *float64       `json:"item_price,omitempty"`           // Price at which the product is added to the deal. Required when attaching
+	Quantity           *float64       `json:"quantity,omitempty"`             // Quantity, e.g. 1, 3, 6. Required when attaching
+	Discount           *float64       `json:"discount,omitempty"`             // Discount of the line item, see DiscountType
+	DiscountType       *DiscountType  `json:"discount_type,omitempty"`        // Whether Discount is a percentage or an amount. Defaults to percentage
+	Duration           *float64       `json:"duration,omitempty"`             // Duration of the product, in DurationUnit. Defaults to 1
+	Tax                *float64       `json:"tax,omitempty"`                  // Tax percentage
+	TaxMethod          *TaxMethod     `json:"tax_method,omitempty"`           // Whether Tax is exclusive, inclusive or not applied
+	Comments           *string        `json:"comments,omitempty"`             // Text to describe this specific line item
+	EnabledFlag        *bool          `json:"enabled_flag,omitempty"`         // Whether the line item is enabled. Disabled line items are not included in the deal value
+}
+
+// ListDealProductsOptions is used to configure a list deal products request.
+type ListDealProductsOptions struct {
+	IncludeProductData *bool `url:"include_product_data,omitempty,int"` // Whether to fetch product data along with each attached product
+	Start              *int  `url:"start,omitempty"`                    // Pagination start
+	Limit              *int  `url:"limit,omitempty"`                    // Items shown per page
+}
+
+// DealProductsSummary is the additional data of a list deal products response.
+type DealProductsSummary struct {
+	ProductsQuantityTotal          float64 `json:"products_quantity_total"`
+	ProductsSumTotal               float64 `json:"products_sum_total"`
+	ProductsQuantityTotalFormatted string  `json:"products_quantity_total_formatted"`
+	ProductsSumTotalFormatted      string  `json:"products_sum_total_formatted"`
+}
+
+// ListDealProducts lists the products attached to a deal. The totals of the
+// deal are in the additional data of the response; see DealProductsSummary.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_products
+func (c *Client) ListDealProducts(ctx context.Context, dealID int, opt *ListDealProductsOptions) (*ListResponse[DealProduct], error) {
+	uri := fmt.Sprintf("/deals/%v/products", dealID)
+	list, _, err := doList[DealProduct](ctx, c, http.MethodGet, uri, opt)
+	return list, err
+}
+
+// AddDealProduct attaches a product to a deal. ProductID, ItemPrice and
+// Quantity are required.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/post_deals_id_products
+func (c *Client) AddDealProduct(ctx context.Context, dealID int, product *DealProduct) (*DealProduct, error) {
+	uri := fmt.Sprintf("/deals/%v/products", dealID)
+	added, _, err := doData[*DealProduct](ctx, c, http.MethodPost, uri, nil, product)
+	return added, err
+}
+
+// UpdateDealProduct updates a product attached to a deal. attachmentID is
+// the ID of the DealProduct, not of the product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/put_deals_id_products_product_attachment_id
+func (c *Client) UpdateDealProduct(ctx context.Context, dealID, attachmentID int, product *DealProduct) (*DealProduct, error) {
+	uri := fmt.Sprintf("/deals/%v/products/%v", dealID, attachmentID)
+	updated, _, err := doData[*DealProduct](ctx, c, http.MethodPut, uri, nil, product)
+	return updated, err
+}
+
+// DeleteDealProduct removes a product from a deal. attachmentID is the ID of
+// the DealProduct, not of the product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id_products_product_attachment_id
+func (c *Client) DeleteDealProduct(ctx context.Context, dealID, attachmentID int) error {
+	uri := fmt.Sprintf("/deals/%v/products/%v", dealID, attachmentID)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// IterateDealProducts returns an Iterator over the products attached to a deal.
+func (c *Client) IterateDealProducts(dealID int, opt *ListDealProductsOptions) *Iterator[DealProduct] {
+	o := copyOptions(opt)
+	return listIterator[DealProduct](c, fmt.Sprintf("/deals/%v/products", dealID), &o.Start, o)
+}
@@ -0,0 +1,112 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDealProducts(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/deals/16143/products":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"product_id":1,"item_price":100,"quantity":3,"discount":10,"discount_type":"amount","duration":12,"tax":10,"tax_method":"inclusive"}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":7,"deal_id":16143,"order_nr":1,"product_id":1,"product_variation_id":null,"item_price":100,"discount":10,"discount_type":"amount","sum":3590,"currency":"AUD","enabled_flag":true,"add_time":"2020-06-01 02:41:35","last_edit":"2020-06-01 02:41:35","comments":null,"active_flag":true,"tax":10,"tax_method":"inclusive","name":"Widget","quantity":3,"duration":12,"duration_unit":"month"}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/deals/16143/products/7":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"quantity":5,"product_variation_id":2}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":7,"deal_id":16143,"product_id":1,"product_variation_id":2,"quantity":5}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/deals/16143/products/7":
+			w.Write([]byte(`{"success":true,"data":{"id":7}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/deals/16143/products":
+			assert.Equal(t, "1", req.URL.Query().Get("include_product_data"))
+			w.Write([]byte(`{"success":true,"data":[{"id":7,"deal_id":16143,"product_id":1,"item_price":100,"quantity":3,"sum":300,"currency":"AUD","name":"Widget"},{"id":8,"deal_id":16143,"product_id":2,"item_price":50,"quantity":1,"discount":10,"discount_type":"percentage","sum":45,"currency":"AUD","name":"Wrench"}],"additional_data":{"products_quantity_total":4,"products_sum_total":345,"products_quantity_total_formatted":"4","products_sum_total_formatted":"A$345","pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test add deal product", func(t *testing.T) {
+		productID := 1
+		price, quantity, discount, duration, tax := 100.0, 3.0, 10.0, 12.0, 10.0
+		discountType := DiscountAmount
+		taxMethod := TaxInclusive
+
+		added, err := testClient.AddDealProduct(context.Background(), 16143, &DealProduct{
+			ProductID:    &productID,
+			ItemPrice:    &price,
+			Quantity:     &quantity,
+			Discount:     &discount,
+			DiscountType: &discountType,
+			Duration:     &duration,
+			Tax:          &tax,
+			TaxMethod:    &taxMethod,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 7, added.ID)
+		assert.Equal(t, 3590.0, added.Sum)
+		assert.Equal(t, "month", added.DurationUnit)
+		assert.True(t, *added.EnabledFlag)
+		_, ok := added.ProductVariationID.Get()
+		assert.False(t, ok)
+	})
+
+	t.Run("Test update deal product", func(t *testing.T) {
+		quantity := 5.0
+		updated, err := testClient.UpdateDealProduct(context.Background(), 16143, 7, &DealProduct{
+			Quantity:           &quantity,
+			ProductVariationID: NewNullable(2),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 5.0, *updated.Quantity)
+	})
+
+	t.Run("Test delete deal product", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteDealProduct(context.Background(), 16143, 7))
+	})
+
+	t.Run("Test list deal products", func(t *testing.T) {
+		includeProductData := true
+		list, err := testClient.ListDealProducts(context.Background(), 16143, &ListDealProductsOptions{IncludeProductData: &includeProductData})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, list.Data, 2) {
+			assert.Equal(t, DiscountPercentage, *list.Data[1].DiscountType)
+			assert.Equal(t, 45.0, list.Data[1].Sum)
+		}
+
+		summary := DealProductsSummary{}
+		assert.Nil(t, list.DecodeAdditionalData(&summary))
+		assert.Equal(t, 345.0, summary.ProductsSumTotal)
+		assert.Equal(t, "A$345", summary.ProductsSumTotalFormatted)
+
+		products, err := testClient.IterateDealProducts(16143, &ListDealProductsOptions{IncludeProductData: &includeProductData}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, products, 2)
+	})
+}
@@ -29,6 +29,7 @@ type Deal interface {
 }
 
 // BaseDealObject represents a basic Pipedrive deal.
+// Nil fields are left unchanged by updates; see Nullable.
 type BaseDealObject struct {
 	// Unsettable Fields
 	ID         int    `json:"id,omitempty"`
@@ -38,24 +39,30 @@ type BaseDealObject struct {
 	OrgName    string `json:"org_name,omitempty"`
 	OwnerName  string `json:"owner_name,omitempty"`
 
+	AddTime         *DateTime `json:"add_time,omitempty"`
+	UpdateTime      *DateTime `json:"update_time,omitempty"`
+	StageChangeTime *DateTime `json:"stage_change_time,omitempty"`
+	CloseTime       *DateTime `json:"close_time,omitempty"`
+	WonTime         *DateTime `json:"won_time,omitempty"`
+	LostTime        *DateTime `json:"lost_time,omitempty"`
+
 	// Settable Fields
-	Title        *string     `json:"title,omitempty"`
-	Value        *float64    `json:"value,omitempty"`
-	StageID      *int        `json:"stage_id,omitempty"`
-	PersonID     *PersonID   `json:"person_id,omitempty"`
-	OrgID        *OrgID      `json:"org_id"`
-	UserID       *UserID     `json:"user_id,omitempty"`
-	Status       *DealStatus `json:"status,omitempty"`
-	LostReason   *string     `json:"lost_reason,omitempty"`
-	PipelineID   *int        `json:"pipeline_id,omitempty"`
-	StageOrderNr *int        `json:"stage_order_nr,omitempty"`
+	Title        *string             `json:"title,omitempty"`
+	Value        *Nullable[float64]  `json:"value,omitempty"`
+	StageID      *int                `json:"stage_id,omitempty"`
+	PersonID     *Nullable[PersonID] `json:"person_id,omitempty"`
+	OrgID        *Nullable[OrgID]    `json:"org_id,omitempty"`
+	UserID       *UserID             `json:"user_id,omitempty"`
+	Status       *DealStatus         `json:"status,omitempty"`
+	LostReason   *Nullable[string]   `json:"lost_reason,omitempty"`
+	PipelineID   *int                `json:"pipeline_id,omitempty"`
+	StageOrderNr *int                `json:"stage_order_nr,omitempty"`
+
+	ExpectedCloseDate *Nullable[Date] `json:"expected_close_date,omitempty"`
 
 	// Unused fields
 	// Currency   string `json:"currency,omitempty"`
-	// AddTime    string `json:"add_time,omitempty"`
-	// UpdateTime string `json:"update_time,omitempty"`
 	// CreatorUserID   interface{} `json:"creator_user_id,omitempty"`
-	// StageChangeTime string      `json:"stage_change_time,omitempty"`
 	// Probability      interface{} `json:"probability,omitempty"`
 	// NextActivityDate interface{} `json:"next_activity_date,omitempty"`
 	// NextActivityTime interface{} `json:"next_activity_time,omitempty"`
@@ -63,10 +70,7 @@ type BaseDealObject struct {
 	// LastActivityID   int         `json:"last_activity_id,omitempty"`
 	// LastActivityDate string      `json:"last_activity_date,omitempty"`
 	// VisibleTo                string      `json:"visible_to,omitempty"`
-	// CloseTime                string      `json:"close_time,omitempty"`
-	// WonTime                  interface{} `json:"won_time,omitempty"`
 	// FirstWonTime             interface{} `json:"first_won_time,omitempty"`
-	// LostTime                 string      `json:"lost_time,omitempty"`
 	// ProductsCount            int         `json:"products_count,omitempty"`
 	// FilesCount               int         `json:"files_count,omitempty"`
 	// NotesCount               int         `json:"notes_count,omitempty"`
@@ -77,7 +81,6 @@ type BaseDealObject struct {
 	// UndoneActivitiesCount    int         `json:"undone_activities_count,omitempty"`
 	// ReferenceActivitiesCount int         `json:"reference_activities_count,omitempty"`
 	// ParticipantsCount        int         `json:"participants_count,omitempty"`
-	// ExpectedCloseDate        interface{} `json:"expected_close_date,omitempty"`
 	// LastIncomingMailTime     interface{} `json:"last_incoming_mail_time,omitempty"`
 	// LastOutgoingMailTime     interface{} `json:"last_outgoing_mail_time,omitempty"`
 	// NextActivitySubject    interface{} `json:"next_activity_subject,omitempty"`
@@ -107,7 +110,7 @@ func (c *Client) CreateDeal(ctx context.Context, deal Deal, out ResponseModel) e
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
@@ -126,6 +129,9 @@ func (c *Client) UpdateDeal(ctx context.Context, id int, deal Deal, out Response
 	if err != nil {
 		return err
 	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
 
 	return nil
 }
@@ -146,7 +152,7 @@ func (c *Client) DeleteDeal(ctx context.Context, id int) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 	return nil
 }
@@ -168,7 +174,7 @@ func (c *Client) DeleteDeals(ctx context.Context, ids []int) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString()) // As of writing this, endpoint may not report errors correctly
+		return unsuccessful(out) // As of writing this, endpoint may not report errors correctly
 	}
 
 	return nil
@@ -192,6 +198,8 @@ type SearchDealsResponse struct {
 	Data      DealItems `json:"data,omitempty"`
 	Error     string    `json:"error,omitempty"`
 	ErrorInfo string    `json:"error_info,omitempty"`
+
+	AdditionalData AdditionalData `json:"additional_data,omitempty"`
 }
 
 // DealItems contains a list of DealItem
@@ -248,7 +256,7 @@ func (c *Client) SearchDeals(ctx context.Context, opt *SearchDealsOptions) (*Sea
 		return nil, err
 	}
 	if !out.Success {
-		return nil, fmt.Errorf("not successful, error: %v", out.Error)
+		return nil, &UnsuccessfulError{Message: out.Error, ErrorInfo: out.ErrorInfo}
 	}
 
 	return out, nil
@@ -277,7 +285,7 @@ func (c *Client) ListDeals(ctx context.Context, personID int, opt *ListDealOptio
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
@@ -296,8 +304,40 @@ func (c *Client) GetDeal(ctx context.Context, id int, out ResponseModel) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
 }
+
+// GetDeal gets a deal by ID, decoded into T.
+func GetDeal[T Deal](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/deals/%v", id), nil, nil)
+}
+
+// CreateDeal creates a deal and returns it decoded into T.
+func CreateDeal[T Deal](ctx context.Context, c *Client, deal Deal) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPost, "/deals", nil, deal)
+}
+
+// UpdateDeal updates a deal and returns it decoded into T.
+func UpdateDeal[T Deal](ctx context.Context, c *Client, id int, deal Deal) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/deals/%v", id), nil, deal)
+}
+
+// ListDeals lists deals belonging to a person, decoded into T.
+func ListDeals[T Deal](ctx context.Context, c *Client, personID int, opt *ListDealOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, fmt.Sprintf("/persons/%v/deals", personID), opt)
+}
+
+// IterateDeals returns an Iterator over the deals of a person, decoded into T.
+func IterateDeals[T Deal](c *Client, personID int, opt *ListDealOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, fmt.Sprintf("/persons/%v/deals", personID), &o.Start, o)
+}
+
+// IterateSearchDeals returns an Iterator over the results of a deal search.
+func (c *Client) IterateSearchDeals(opt *SearchDealsOptions) *Iterator[DealItem] {
+	o := copyOptions(opt)
+	return searchIterator[DealItem](c, "/deals/search", o.Term, &o.Start, o)
+}
@@ -0,0 +1,117 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// DataResponse is a typed response envelope. Unlike BaseResponse, it needs no
+// preallocated Data and hands back T directly.
+//
+// AdditionalData and RelatedObjects are kept raw, since their shape depends
+// on the endpoint; decode them with DecodeAdditionalData and
+// DecodeRelatedObjects.
+type DataResponse[T any] struct {
+	Success        bool            `json:"success"`
+	Data           T               `json:"data"`
+	Error          string          `json:"error,omitempty"`
+	ErrorInfo      string          `json:"error_info,omitempty"`
+	AdditionalData json.RawMessage `json:"additional_data,omitempty"`
+	RelatedObjects json.RawMessage `json:"related_objects,omitempty"`
+}
+
+func (r *DataResponse[T]) Successful() bool {
+	return r.Success
+}
+
+func (r *DataResponse[T]) ErrorString() string {
+	return r.Error
+}
+
+// DecodeAdditionalData decodes the additional_data of the response into v.
+func (r *DataResponse[T]) DecodeAdditionalData(v interface{}) error {
+	return decodeRaw(r.AdditionalData, v)
+}
+
+// DecodeRelatedObjects decodes the related_objects of the response into v.
+func (r *DataResponse[T]) DecodeRelatedObjects(v interface{}) error {
+	return decodeRaw(r.RelatedObjects, v)
+}
+
+// Related decodes the related_objects of the response.
+func (r *DataResponse[T]) Related() (*RelatedObjects, error) {
+	related := &RelatedObjects{}
+	err := r.DecodeRelatedObjects(related)
+
+	return related, err
+}
+
+// ListResponse is a typed response envelope of list endpoints.
+type ListResponse[T any] struct {
+	DataResponse[[]T]
+}
+
+// Pagination returns the pagination of the response, if any.
+func (r *ListResponse[T]) Pagination() (Pagination, error) {
+	var additional AdditionalData
+	err := r.DecodeAdditionalData(&additional)
+
+	return additional.Pagination, err
+}
+
+func decodeRaw(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// do sends a request to a v1 endpoint and decodes the response envelope into out.
+func do(ctx context.Context, c *Client, method, uri string, opt interface{}, body interface{}, out ResponseModel) (*Response, error) {
+	req, err := c.NewRequest(method, uri, opt, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doRequest(ctx, c, req, out)
+}
+
+// doRequest sends req and decodes the response envelope into out.
+func doRequest(ctx context.Context, c *Client, req *http.Request, out ResponseModel) (*Response, error) {
+	resp, err := c.Do(ctx, req, out)
+	if err != nil {
+		return resp, err
+	}
+	if !out.Successful() {
+		return resp, unsuccessful(out)
+	}
+
+	return resp, nil
+}
+
+// doData sends a request and returns the typed data of the response.
+func doData[T any](ctx context.Context, c *Client, method, uri string, opt interface{}, body interface{}) (T, *Response, error) {
+	out := &DataResponse[T]{}
+
+	resp, err := do(ctx, c, method, uri, opt, body, out)
+	if err != nil {
+		var zero T
+		return zero, resp, err
+	}
+
+	return out.Data, resp, nil
+}
+
+// doList sends a request and returns the typed list of the response.
+func doList[T any](ctx context.Context, c *Client, method, uri string, opt interface{}) (*ListResponse[T], *Response, error) {
+	out := &ListResponse[T]{}
+
+	resp, err := do(ctx, c, method, uri, opt, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return out, resp, nil
+}
@@ -0,0 +1,98 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type customDeal struct {
+	BaseDealObject
+	LoanAmount string `json:"f68bc64c61ed5be74939265930336b9424d7c39b"`
+}
+
+func TestGenericDeals(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/v1/deals/5":
+			w.Write([]byte(`{"success":true,"data":{"id":5,"title":"test 456","f68bc64c61ed5be74939265930336b9424d7c39b":"custom"},"related_objects":{"user":{"11535881":{"id":11535881,"name":"Tom Shi"}}}}`))
+		case "/v1/persons/3/deals":
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"title":"one"},{"id":2,"title":"two"}],"additional_data":{"pagination":{"start":0,"limit":2,"more_items_in_collection":true}}}`))
+		default:
+			w.WriteHeader(404)
+			w.Write([]byte(`{"success":false,"error":"Deal not found"}`))
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test get custom deal", func(t *testing.T) {
+		deal, resp, err := GetDeal[customDeal](context.Background(), testClient, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, 5, deal.ID)
+		assert.Equal(t, "test 456", *deal.Title)
+		assert.Equal(t, "custom", deal.LoanAmount)
+	})
+
+	t.Run("Test get pointer deal", func(t *testing.T) {
+		deal, _, err := GetDeal[*BaseDealObject](context.Background(), testClient, 5)
+		if assert.Nil(t, err) {
+			assert.Equal(t, 5, deal.ID)
+		}
+	})
+
+	t.Run("Test list deals", func(t *testing.T) {
+		list, _, err := ListDeals[BaseDealObject](context.Background(), testClient, 3, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, list.Data, 2) {
+			assert.Equal(t, "two", *list.Data[1].Title)
+		}
+
+		pagination, err := list.Pagination()
+		assert.Nil(t, err)
+		assert.True(t, pagination.MoreItemsInCollection)
+	})
+
+	t.Run("Test not found", func(t *testing.T) {
+		_, _, err := GetDeal[BaseDealObject](context.Background(), testClient, 1)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func TestDataResponse(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"id":1,"content":"hello"},"related_objects":{"user":{"1":{"id":1,"name":"Tom"}}}}`))
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	out := &DataResponse[BaseNoteObject]{}
+	err := testClient.GetNote(context.Background(), 1, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "hello", *out.Data.Content)
+
+	var related struct {
+		User map[int]struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	assert.Nil(t, out.DecodeRelatedObjects(&related))
+	assert.Equal(t, "Tom", related.User[1].Name)
+}
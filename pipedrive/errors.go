@@ -1,10 +1,34 @@
 package pipedrive
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// Sentinel errors to match API errors against with errors.Is.
+var (
+	ErrValidation   = errors.New("pipedrive: validation failed")
+	ErrUnauthorized = errors.New("pipedrive: unauthorized")
+	ErrForbidden    = errors.New("pipedrive: forbidden")
+	ErrNotFound     = errors.New("pipedrive: not found")
+	ErrGone         = errors.New("pipedrive: gone")
+	ErrRateLimited  = errors.New("pipedrive: rate limited")
+	ErrServer       = errors.New("pipedrive: server error")
+
+	// ErrUnsuccessful matches responses with a 2xx status whose success flag is false.
+	ErrUnsuccessful = errors.New("pipedrive: not successful")
+)
+
+// ErrMissingSearchTerm is returned by search iterators without a search term.
+// It's detected by the client, without calling the API.
+var ErrMissingSearchTerm = errors.New("pipedrive: search term is required")
+
+// Headers that may carry the ID Pipedrive assigned to a request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id"}
+
 // RateLimitError occurs when Pipedrive returns 403 Forbidden response with a rate limit
 // remaining value of 0.
 type RateLimitError struct {
@@ -15,12 +39,17 @@ type RateLimitError struct {
 
 func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("%v %v: %d %v",
-		e.Response.Request.Method, e.Response.Request.URL,
+		e.Response.Request.Method, redactedURL(e.Response.Request.URL),
 		e.Response.StatusCode, e.Message)
 }
 
-// ResponseError sometimes error is a custom struct instead of string
-// TODO: expand on different error handling
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// ResponseError is the raw JSON value of the error field. It's usually a
+// string, but some endpoints return an object, see ErrorDetail.
 type ResponseError string
 
 func (e *ResponseError) UnmarshalJSON(b []byte) error {
@@ -28,13 +57,140 @@ func (e *ResponseError) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// ErrorDetail is the object variant of the error field, returned by
+// endpoints such as search. Use errors.As to get it from an ErrorResponse.
+type ErrorDetail struct {
+	Message      string   `json:"message"`
+	Code         int      `json:"code"`
+	FailedFields []string `json:"failed_fields"`
+}
+
+func (d *ErrorDetail) Error() string {
+	return d.Message
+}
+
 // ErrorResponse reports one or more errors caused by an API request.
 type ErrorResponse struct {
-	Response *http.Response
-	Message  ResponseError `json:"error"`
+	Response   *http.Response
+	StatusCode int
+	RequestID  string
+	Body       []byte // Raw response body.
+
+	Success   bool            `json:"success"`
+	Message   ResponseError   `json:"error"`
+	ErrorInfo string          `json:"error_info"`
+	Data      json.RawMessage `json:"data"`
+
+	// Detail is set when the error field is an object.
+	Detail *ErrorDetail `json:"-"`
+}
+
+// newErrorResponse builds the ErrorResponse of an unsuccessful response and its body.
+func newErrorResponse(r *http.Response, body []byte) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:   r,
+		StatusCode: r.StatusCode,
+		Body:       body,
+	}
+
+	for _, header := range requestIDHeaders {
+		if id := r.Header.Get(header); id != "" {
+			e.RequestID = id
+			break
+		}
+	}
+
+	// Bodies that aren't JSON, such as proxy error pages, are kept in Body only.
+	if json.Unmarshal(body, e) == nil {
+		if raw := bytes.TrimSpace([]byte(e.Message)); len(raw) > 0 && raw[0] == '{' {
+			detail := &ErrorDetail{}
+			if json.Unmarshal(raw, detail) == nil {
+				e.Detail = detail
+			}
+		}
+	}
+
+	return e
 }
 
 func (e *ErrorResponse) Error() string {
+	message := string(e.Message)
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
 	return fmt.Sprintf("%v: %d %v",
-		e.Response.Request.Method, e.Response.StatusCode, string(e.Message))
+		e.Response.Request.Method, e.StatusCode, message)
+}
+
+// ErrorMessage returns the error message without JSON quoting.
+func (e *ErrorResponse) ErrorMessage() string {
+	if e.Detail != nil {
+		return e.Detail.Message
+	}
+
+	var message string
+	if json.Unmarshal([]byte(e.Message), &message) == nil {
+		return message
+	}
+
+	return string(e.Message)
+}
+
+// Is matches the sentinel error corresponding to the status code.
+func (e *ErrorResponse) Is(target error) bool {
+	switch code := e.StatusCode; target {
+	case ErrValidation:
+		return code == http.StatusBadRequest || code == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return code == http.StatusUnauthorized
+	case ErrForbidden:
+		return code == http.StatusForbidden
+	case ErrNotFound:
+		return code == http.StatusNotFound
+	case ErrGone:
+		return code == http.StatusGone
+	case ErrRateLimited:
+		return code == http.StatusTooManyRequests
+	case ErrServer:
+		return code >= 500
+	}
+
+	return false
+}
+
+// Unwrap returns the ErrorDetail, if any.
+func (e *ErrorResponse) Unwrap() error {
+	if e.Detail == nil {
+		return nil
+	}
+
+	return e.Detail
+}
+
+// UnsuccessfulError occurs when the API responds with a 2xx status code but
+// reports success as false.
+type UnsuccessfulError struct {
+	Message   string
+	ErrorInfo string
+}
+
+func (e *UnsuccessfulError) Error() string {
+	return fmt.Sprintf("not successful, error: %v", e.Message)
+}
+
+// Is reports whether target is ErrUnsuccessful.
+func (e *UnsuccessfulError) Is(target error) bool {
+	return target == ErrUnsuccessful
+}
+
+// unsuccessful returns the UnsuccessfulError of a response model.
+func unsuccessful(out ResponseModel) error {
+	err := &UnsuccessfulError{Message: out.ErrorString()}
+
+	if base, ok := out.(*BaseResponse); ok {
+		err.ErrorInfo = base.ErrorInfo
+	}
+
+	return err
 }
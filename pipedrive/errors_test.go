@@ -0,0 +1,114 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newErrorTestClient(t *testing.T, status int, header http.Header, body string) (*Client, func()) {
+	t.Helper()
+
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+
+	return NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL}), testAPI.Close
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Run("Test string error", func(t *testing.T) {
+		testClient, done := newErrorTestClient(t, 404, http.Header{"X-Request-Id": {"abc123"}},
+			`{"success":false,"error":"Deal not found","error_info":"Please check developers.pipedrive.com for more information about Pipedrive API.","data":null}`)
+		defer done()
+
+		err := testClient.GetDeal(context.Background(), 1, &BaseResponse{Data: &BaseDealObject{}})
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.False(t, errors.Is(err, ErrValidation))
+
+		var errorResponse *ErrorResponse
+		if assert.True(t, errors.As(err, &errorResponse)) {
+			assert.Equal(t, 404, errorResponse.StatusCode)
+			assert.Equal(t, "abc123", errorResponse.RequestID)
+			assert.Equal(t, "Deal not found", errorResponse.ErrorMessage())
+			assert.Contains(t, errorResponse.ErrorInfo, "developers.pipedrive.com")
+			assert.Contains(t, string(errorResponse.Body), `"success":false`)
+			assert.Nil(t, errorResponse.Detail)
+		}
+	})
+
+	t.Run("Test object error", func(t *testing.T) {
+		testClient, done := newErrorTestClient(t, 400, nil,
+			`{"success":false,"error":{"message":"Invalid input","code":400,"failed_fields":["term"]}}`)
+		defer done()
+
+		_, err := testClient.SearchDeals(context.Background(), &SearchDealsOptions{})
+		assert.True(t, errors.Is(err, ErrValidation))
+
+		var detail *ErrorDetail
+		if assert.True(t, errors.As(err, &detail)) {
+			assert.Equal(t, "Invalid input", detail.Message)
+			assert.Equal(t, []string{"term"}, detail.FailedFields)
+		}
+	})
+
+	t.Run("Test sentinels by status", func(t *testing.T) {
+		for status, sentinel := range map[int]error{
+			401: ErrUnauthorized,
+			403: ErrForbidden,
+			410: ErrGone,
+			422: ErrValidation,
+			429: ErrRateLimited,
+			502: ErrServer,
+		} {
+			testClient, done := newErrorTestClient(t, status, nil, `<html>error</html>`)
+
+			err := testClient.GetNote(context.Background(), 1, &BaseResponse{})
+			assert.True(t, errors.Is(err, sentinel), "status %d", status)
+
+			done()
+		}
+	})
+
+	t.Run("Test rate limit error", func(t *testing.T) {
+		testClient, done := newErrorTestClient(t, 403, http.Header{"X-Ratelimit-Remaining": {"0"}}, `{"success":false,"error":"Rate limit exceeded"}`)
+		defer done()
+
+		err := testClient.GetNote(context.Background(), 1, &BaseResponse{})
+		assert.True(t, errors.Is(err, ErrRateLimited))
+		assert.IsType(t, &RateLimitError{}, err)
+	})
+
+	t.Run("Test gone on delete is not an error", func(t *testing.T) {
+		testClient, done := newErrorTestClient(t, 410, nil, `{"success":true}`)
+		defer done()
+
+		assert.Nil(t, testClient.DeleteNote(context.Background(), 1))
+	})
+
+	t.Run("Test unsuccessful response", func(t *testing.T) {
+		testClient, done := newErrorTestClient(t, 200, nil, `{"success":false,"error":"Something went wrong","error_info":"info"}`)
+		defer done()
+
+		err := testClient.GetNote(context.Background(), 1, &BaseResponse{})
+		assert.True(t, errors.Is(err, ErrUnsuccessful))
+
+		var unsuccessfulErr *UnsuccessfulError
+		if assert.True(t, errors.As(err, &unsuccessfulErr)) {
+			assert.Equal(t, "info", unsuccessfulErr.ErrorInfo)
+		}
+		assert.Equal(t, "not successful, error: Something went wrong", err.Error())
+
+		assert.True(t, errors.Is(testClient.UpdateDeal(context.Background(), 1, &BaseDealObject{}, &BaseResponse{}), ErrUnsuccessful))
+		assert.True(t, errors.Is(testClient.UpdatePerson(context.Background(), 1, &BasePersonObject{}, &BaseResponse{}), ErrUnsuccessful))
+		assert.True(t, errors.Is(testClient.UpdateNote(context.Background(), 1, &BaseNoteObject{}, &BaseResponse{}), ErrUnsuccessful))
+	})
+}
@@ -0,0 +1,198 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields
+
+// FieldEntity is an entity that has field definitions.
+type FieldEntity string
+
+const (
+	FieldEntityDeal         FieldEntity = "deal"
+	FieldEntityPerson       FieldEntity = "person"
+	FieldEntityOrganization FieldEntity = "organization"
+	FieldEntityProduct      FieldEntity = "product"
+	FieldEntityActivity     FieldEntity = "activity"
+	FieldEntityNote         FieldEntity = "note"
+)
+
+// path returns the path of the fields endpoint of the entity, e.g. /dealFields.
+func (e FieldEntity) path() string {
+	return "/" + string(e) + "Fields"
+}
+
+// FieldOption is an option of an enum or set field.
+type FieldOption struct {
+	// ID is the option ID. It's numeric for custom fields, but some system
+	// fields use strings, e.g. "open" for the deal status.
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
+// UnmarshalJSON accepts both numeric and string option IDs.
+func (o *FieldOption) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID    json.RawMessage `json:"id"`
+		Label string          `json:"label"`
+		Color string          `json:"color"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*o = FieldOption{
+		ID:    strings.Trim(string(raw.ID), `"`),
+		Label: raw.Label,
+		Color: raw.Color,
+	}
+
+	return nil
+}
+
+// Field is the definition of a field of an entity.
+type Field struct {
+	ID            int           `json:"id"`
+	Key           string        `json:"key"`
+	Name          string        `json:"name"`
+	OrderNr       int           `json:"order_nr,omitempty"`
+	FieldType     FieldType     `json:"field_type"`
+	AddTime       *DateTime     `json:"add_time,omitempty"`
+	UpdateTime    *DateTime     `json:"update_time,omitempty"`
+	ActiveFlag    bool          `json:"active_flag"`
+	EditFlag      bool          `json:"edit_flag"`
+	MandatoryFlag bool          `json:"mandatory_flag"`
+	BulkEditable  bool          `json:"bulk_edit_allowed"`
+	Searchable    bool          `json:"searchable_flag"`
+	Filterable    bool          `json:"filtering_allowed"`
+	Sortable      bool          `json:"sortable_flag"`
+	Options       []FieldOption `json:"options,omitempty"`
+}
+
+// Custom reports whether the field is a custom field. Custom fields have a
+// 40 character hash as key and can be edited.
+func (f *Field) Custom() bool {
+	return f.EditFlag && len(f.Key) == 40
+}
+
+// Option returns the option of an enum or set field with the given label.
+func (f *Field) Option(label string) (FieldOption, bool) {
+	for _, o := range f.Options {
+		if o.Label == label {
+			return o, true
+		}
+	}
+
+	return FieldOption{}, false
+}
+
+// OptionByID returns the option of an enum or set field with the given ID.
+func (f *Field) OptionByID(id string) (FieldOption, bool) {
+	for _, o := range f.Options {
+		if o.ID == id {
+			return o, true
+		}
+	}
+
+	return FieldOption{}, false
+}
+
+// OptionID is the ID of the selected option of an enum field. The API
+// returns it either as a number or as a numeric string.
+type OptionID int
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionID) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" || s == "" {
+		*o = 0
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("pipedrive: invalid option id %s", data)
+	}
+
+	*o = OptionID(n)
+
+	return nil
+}
+
+// OptionIDs are the IDs of the selected options of a set field. They're
+// sent as a comma-separated string.
+type OptionIDs []OptionID
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionIDs) MarshalJSON() ([]byte, error) {
+	ids := make([]string, len(o))
+	for i, id := range o {
+		ids[i] = strconv.Itoa(int(id))
+	}
+
+	return json.Marshal(strings.Join(ids, ","))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionIDs) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" || s == "" {
+		*o = nil
+		return nil
+	}
+
+	ids := OptionIDs{}
+	for _, part := range strings.Split(s, ",") {
+		var id OptionID
+		if err := id.UnmarshalJSON([]byte(strings.TrimSpace(part))); err != nil {
+			return err
+		}
+
+		ids = append(ids, id)
+	}
+
+	*o = ids
+
+	return nil
+}
+
+// ListFieldsOptions is used to configure a list fields request.
+type ListFieldsOptions struct {
+	Start *int `url:"start,omitempty"` // Pagination start
+	Limit *int `url:"limit,omitempty"` // Items shown per page
+}
+
+// ListFields lists the field definitions of an entity.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/get_dealFields
+func (c *Client) ListFields(ctx context.Context, entity FieldEntity, opt *ListFieldsOptions, out ResponseModel) error {
+
+	req, err := c.NewRequest(http.MethodGet, entity.path(), opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// IterateFields returns an Iterator over the field definitions of an entity.
+func IterateFields(c *Client, entity FieldEntity, opt *ListFieldsOptions) *Iterator[Field] {
+	o := copyOptions(opt)
+	return listIterator[Field](c, entity.path(), &o.Start, o)
+}
@@ -2,7 +2,6 @@ package pipedrive
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -40,8 +39,19 @@ func (c *Client) SearchItemFields(ctx context.Context, opt *SearchItemFieldsOpti
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
 }
+
+// IterateItemFields returns an Iterator over the results of a field search,
+// decoded into T.
+func IterateItemFields[T any](c *Client, opt *SearchItemFieldsOptions) *Iterator[T] {
+	if opt == nil || opt.Term == "" {
+		return failedIterator[T](ErrMissingSearchTerm)
+	}
+
+	o := copyOptions(opt)
+	return listIterator[T](c, "/itemSearch/field", &o.Start, o)
+}
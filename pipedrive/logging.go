@@ -0,0 +1,252 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Person fields redacted from logged bodies unless configured otherwise.
+var defaultRedactFields = []string{"email", "phone"}
+
+// Query parameters redacted from logged URLs, since search terms are often
+// PII such as an email address or a phone number.
+var searchParams = []string{"term"}
+
+// CallRecord describes a completed API call. URLs, headers and bodies are
+// redacted before the record is handed to the Logger.
+type CallRecord struct {
+	Method   string
+	Endpoint string // Endpoint template, e.g. /deals/{id}
+	URL      string
+	Status   int
+	Duration time.Duration
+	Rate     Rate
+	Err      error
+
+	// Headers and bodies are only set when body logging is enabled.
+	RequestHeader  http.Header
+	RequestBody    []byte
+	ResponseHeader http.Header
+	ResponseBody   []byte
+}
+
+// Logger receives a record of every API call made by the client.
+type Logger interface {
+	LogCall(ctx context.Context, record *CallRecord)
+}
+
+// WithLogger sets the logger that receives a record of every API call.
+func WithLogger(logger Logger) func(*Client) error {
+	return func(c *Client) error {
+		c.logger = logger
+
+		return nil
+	}
+}
+
+// WithLogBodies enables logging of request and response headers and bodies.
+// Meant for debugging; credentials and PII fields are still redacted.
+func WithLogBodies(enabled bool) func(*Client) error {
+	return func(c *Client) error {
+		c.logBodies = enabled
+
+		return nil
+	}
+}
+
+// WithRedactFields replaces the JSON fields redacted from logged bodies, and
+// the query parameters redacted from logged URLs. Field names are matched
+// case-insensitively at any depth. Credentials and search terms are always
+// redacted.
+func WithRedactFields(fields ...string) func(*Client) error {
+	return func(c *Client) error {
+		c.redactFields = newRedactFields(fields)
+
+		return nil
+	}
+}
+
+func newRedactFields(fields []string) map[string]bool {
+	m := make(map[string]bool, len(fields)+len(credentialParams))
+
+	for _, field := range fields {
+		m[strings.ToLower(field)] = true
+	}
+
+	for _, param := range credentialParams {
+		m[param] = true
+	}
+
+	return m
+}
+
+// logCall builds the record of a call and passes it to the logger.
+func (c *Client) logCall(ctx context.Context, call *Call, response *Response, body []byte, err error, duration time.Duration) {
+	record := &CallRecord{
+		Method:   call.Request.Method,
+		Endpoint: call.Endpoint,
+		URL:      c.redactLoggedURL(call.Request.URL),
+		Duration: duration,
+		Err:      err,
+	}
+
+	if response != nil && response.Response != nil {
+		record.Status = response.StatusCode
+		record.Rate = response.Rate
+	}
+
+	if c.logBodies {
+		record.RequestHeader = redactedHeader(call.Request.Header)
+
+		if call.Request.GetBody != nil {
+			if reqBody, err := call.Request.GetBody(); err == nil {
+				b, _ := ioutil.ReadAll(reqBody)
+				reqBody.Close()
+				record.RequestBody = c.redactBody(b)
+			}
+		}
+
+		if response != nil && response.Response != nil {
+			record.ResponseHeader = redactedHeader(response.Header)
+		}
+
+		record.ResponseBody = c.redactBody(body)
+	}
+
+	c.logger.LogCall(ctx, record)
+}
+
+// redactLoggedURL returns u with the values of credentials, search terms and
+// redacted fields in its query redacted.
+func (c *Client) redactLoggedURL(u *url.URL) string {
+	clean := redactedURL(u)
+	if clean == nil {
+		return ""
+	}
+	if clean.RawQuery == "" {
+		return clean.String()
+	}
+
+	q := clean.Query()
+	changed := false
+
+	for param := range q {
+		if c.redactFields[strings.ToLower(param)] || isSearchParam(param) {
+			q.Set(param, redacted)
+			changed = true
+		}
+	}
+
+	if changed {
+		clean.RawQuery = q.Encode()
+	}
+
+	return clean.String()
+}
+
+func isSearchParam(param string) bool {
+	for _, p := range searchParams {
+		if strings.EqualFold(param, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactBody replaces the values of redacted fields in a JSON body. Bodies
+// that aren't JSON are dropped rather than risk leaking them.
+func (c *Client) redactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []byte(redacted)
+	}
+
+	b, err := json.Marshal(redactValue(v, c.redactFields))
+	if err != nil {
+		return []byte(redacted)
+	}
+
+	return b
+}
+
+func redactValue(v interface{}, fields map[string]bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if fields[strings.ToLower(key)] {
+				value[key] = redacted
+			} else {
+				value[key] = redactValue(field, fields)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item, fields)
+		}
+	}
+
+	return v
+}
+
+// SlogLogger adapts a *slog.Logger to the Logger interface. Successful calls
+// are logged at Level, failed ones at slog.LevelWarn.
+type SlogLogger struct {
+	Logger *slog.Logger
+	Level  slog.Level
+}
+
+// NewSlogLogger returns a Logger writing debug records to l.
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	return &SlogLogger{
+		Logger: l,
+		Level:  slog.LevelDebug,
+	}
+}
+
+func (s *SlogLogger) LogCall(ctx context.Context, record *CallRecord) {
+	level := s.Level
+	if record.Err != nil && level < slog.LevelWarn {
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", record.Method),
+		slog.String("endpoint", record.Endpoint),
+		slog.String("url", record.URL),
+		slog.Int("status", record.Status),
+		slog.Duration("duration", record.Duration),
+	}
+
+	if record.Rate.Limit > 0 {
+		attrs = append(attrs, slog.Group("rate",
+			slog.Int("limit", record.Rate.Limit),
+			slog.Int("remaining", record.Rate.Remaining),
+			slog.Time("reset", record.Rate.Reset.Time),
+		))
+	}
+
+	if record.Err != nil {
+		attrs = append(attrs, slog.String("error", record.Err.Error()))
+	}
+
+	if record.RequestBody != nil {
+		attrs = append(attrs, slog.String("request_body", string(record.RequestBody)))
+	}
+
+	if record.ResponseBody != nil {
+		attrs = append(attrs, slog.String("response_body", string(record.ResponseBody)))
+	}
+
+	s.Logger.LogAttrs(ctx, level, "pipedrive api call", attrs...)
+}
@@ -0,0 +1,129 @@
+package pipedrive
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	records []*CallRecord
+}
+
+func (l *recordingLogger) LogCall(ctx context.Context, record *CallRecord) {
+	l.records = append(l.records, record)
+}
+
+func TestLogger(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "80")
+		w.Header().Set("X-RateLimit-Remaining", "70")
+		w.WriteHeader(201)
+		w.Write([]byte(`{"success":true,"data":{"id":3,"name":"John","email":[{"value":"john@example.com","primary":true}],"phone":[{"value":"0400000000","primary":true}]}}`))
+	}))
+	defer testAPI.Close()
+
+	t.Run("Test record without bodies", func(t *testing.T) {
+		logger := &recordingLogger{}
+		testClient := NewClient(&Config{APIKey: "secret-token", BaseURL: testAPI.URL})
+		testClient.SetOptions(WithLogger(logger))
+
+		name := "John"
+		err := testClient.CreatePerson(context.Background(), &BasePersonObject{Name: &name}, &BaseResponse{Data: &BasePersonObject{}})
+		assert.Nil(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			record := logger.records[0]
+			assert.Equal(t, "POST", record.Method)
+			assert.Equal(t, "/persons", record.Endpoint)
+			assert.Equal(t, 201, record.Status)
+			assert.Equal(t, 70, record.Rate.Remaining)
+			assert.Nil(t, record.RequestBody)
+			assert.Nil(t, record.ResponseBody)
+		}
+	})
+
+	t.Run("Test bodies are redacted", func(t *testing.T) {
+		logger := &recordingLogger{}
+		testClient := NewClient(&Config{APIKey: "secret-token", BaseURL: testAPI.URL})
+		testClient.SetOptions(WithLogger(logger), WithLogBodies(true))
+
+		name := "John"
+		person := &BasePersonObject{Name: &name, Email: []*Email{{Value: "john@example.com"}}}
+		err := testClient.CreatePerson(context.Background(), person, &BaseResponse{Data: &BasePersonObject{}})
+		assert.Nil(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			record := logger.records[0]
+			assert.Equal(t, "REDACTED", record.RequestHeader.Get("x-api-token"))
+			assert.Contains(t, string(record.RequestBody), `"name":"John"`)
+			assert.NotContains(t, string(record.RequestBody), "john@example.com")
+			assert.NotContains(t, string(record.ResponseBody), "john@example.com")
+			assert.NotContains(t, string(record.ResponseBody), "0400000000")
+		}
+	})
+
+	t.Run("Test configurable redact fields", func(t *testing.T) {
+		logger := &recordingLogger{}
+		testClient := NewClient(&Config{APIKey: "secret-token", BaseURL: testAPI.URL})
+		testClient.SetOptions(WithLogger(logger), WithLogBodies(true), WithRedactFields("name"))
+
+		err := testClient.GetPerson(context.Background(), 3, &BaseResponse{Data: &BasePersonObject{}})
+		assert.Nil(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			assert.NotContains(t, string(logger.records[0].ResponseBody), "John")
+			assert.Contains(t, string(logger.records[0].ResponseBody), "john@example.com")
+		}
+	})
+
+	t.Run("Test search terms are redacted from URLs", func(t *testing.T) {
+		logger := &recordingLogger{}
+		testClient := NewClient(&Config{APIKey: "secret-token", BaseURL: testAPI.URL})
+		testClient.SetOptions(WithLogger(logger), WithRedactFields("email", "phone"))
+
+		_, err := testClient.SearchPersons(context.Background(), &SearchPersonsOptions{Term: "jane@example.com"})
+		assert.Nil(t, err)
+
+		req, err := testClient.NewRequest(http.MethodGet, "/persons", &struct {
+			Email string `url:"email"`
+			Start int    `url:"start"`
+		}{Email: "jane@example.com"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = testClient.Do(context.Background(), req, &BaseResponse{})
+		assert.Nil(t, err)
+
+		if assert.Len(t, logger.records, 2) {
+			assert.NotContains(t, logger.records[0].URL, "jane")
+			assert.Contains(t, logger.records[0].URL, "term=REDACTED")
+
+			assert.NotContains(t, logger.records[1].URL, "jane")
+			assert.Contains(t, logger.records[1].URL, "email=REDACTED")
+			assert.Contains(t, logger.records[1].URL, "start=0")
+		}
+	})
+
+	t.Run("Test slog adapter", func(t *testing.T) {
+		var buf bytes.Buffer
+		handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+		testClient := NewClient(&Config{APIKey: "secret-token", BaseURL: testAPI.URL})
+		testClient.SetOptions(WithLogger(NewSlogLogger(slog.New(handler))), WithLogBodies(true))
+
+		err := testClient.GetPerson(context.Background(), 3, &BaseResponse{Data: &BasePersonObject{}})
+		assert.Nil(t, err)
+
+		out := buf.String()
+		assert.Contains(t, out, "endpoint=/persons/{id}")
+		assert.Contains(t, out, "status=201")
+		assert.Contains(t, out, "rate.remaining=70")
+		assert.NotContains(t, out, "secret-token")
+		assert.NotContains(t, out, "john@example.com")
+	})
+}
@@ -0,0 +1,211 @@
+package pipedrive
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives measurements of the API calls made by the client.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveCall records a completed call. Status is 0 when no response
+	// was received.
+	ObserveCall(method, endpoint string, status int, duration time.Duration)
+
+	// ObserveRetry records a retry of a call.
+	ObserveRetry(method, endpoint string)
+
+	// ObserveRate records the latest rate limit reported by the API.
+	ObserveRate(rate Rate)
+}
+
+// WithMetrics sets the metrics recorder of the client.
+func WithMetrics(metrics Metrics) func(*Client) error {
+	return func(c *Client) error {
+		c.metrics = metrics
+
+		return nil
+	}
+}
+
+// DefaultLatencyBuckets are the latency histogram buckets, in seconds, used
+// when none are given to NewPrometheusMetrics.
+var DefaultLatencyBuckets = []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics keeps the client metrics in memory and serves them in
+// the Prometheus text exposition format.
+type PrometheusMetrics struct {
+	mu sync.Mutex
+
+	buckets   []float64
+	latencies map[callKey]*histogram
+	responses map[responseKey]uint64
+	retries   map[callKey]uint64
+	rate      Rate
+}
+
+type callKey struct {
+	method   string
+	endpoint string
+}
+
+type responseKey struct {
+	callKey
+	status int
+}
+
+type histogram struct {
+	counts []uint64 // Per bucket, not cumulative.
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics returns an empty PrometheusMetrics using the given
+// latency buckets, or DefaultLatencyBuckets if none are given.
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &PrometheusMetrics{
+		buckets:   buckets,
+		latencies: make(map[callKey]*histogram),
+		responses: make(map[responseKey]uint64),
+		retries:   make(map[callKey]uint64),
+	}
+}
+
+func (m *PrometheusMetrics) ObserveCall(method, endpoint string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := callKey{method: method, endpoint: endpoint}
+
+	h, ok := m.latencies[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.latencies[key] = h
+	}
+
+	seconds := duration.Seconds()
+	for i, bound := range m.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += seconds
+
+	m.responses[responseKey{callKey: key, status: status}]++
+}
+
+func (m *PrometheusMetrics) ObserveRetry(method, endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retries[callKey{method: method, endpoint: endpoint}]++
+}
+
+func (m *PrometheusMetrics) ObserveRate(rate Rate) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rate = rate
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format.
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("# HELP pipedrive_request_duration_seconds Latency of Pipedrive API calls.\n")
+	b.WriteString("# TYPE pipedrive_request_duration_seconds histogram\n")
+	for _, key := range sortedCallKeys(m.latencies) {
+		h := m.latencies[key]
+		labels := key.labels()
+
+		var cumulative uint64
+		for i, bound := range m.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(&b, "pipedrive_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n",
+				labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&b, "pipedrive_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(&b, "pipedrive_request_duration_seconds_sum{%s} %s\n", labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "pipedrive_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	b.WriteString("# HELP pipedrive_responses_total Pipedrive API responses by status code.\n")
+	b.WriteString("# TYPE pipedrive_responses_total counter\n")
+	responseKeys := make([]responseKey, 0, len(m.responses))
+	for key := range m.responses {
+		responseKeys = append(responseKeys, key)
+	}
+	sort.Slice(responseKeys, func(i, j int) bool {
+		if responseKeys[i].callKey != responseKeys[j].callKey {
+			return responseKeys[i].callKey.less(responseKeys[j].callKey)
+		}
+		return responseKeys[i].status < responseKeys[j].status
+	})
+	for _, key := range responseKeys {
+		fmt.Fprintf(&b, "pipedrive_responses_total{%s,code=\"%d\"} %d\n", key.labels(), key.status, m.responses[key])
+	}
+
+	b.WriteString("# HELP pipedrive_retries_total Retries of Pipedrive API calls.\n")
+	b.WriteString("# TYPE pipedrive_retries_total counter\n")
+	for _, key := range sortedCallKeys(m.retries) {
+		fmt.Fprintf(&b, "pipedrive_retries_total{%s} %d\n", key.labels(), m.retries[key])
+	}
+
+	b.WriteString("# HELP pipedrive_rate_limit Requests allowed per rate limit window.\n")
+	b.WriteString("# TYPE pipedrive_rate_limit gauge\n")
+	fmt.Fprintf(&b, "pipedrive_rate_limit %d\n", m.rate.Limit)
+	b.WriteString("# HELP pipedrive_rate_limit_remaining Requests left in the current rate limit window.\n")
+	b.WriteString("# TYPE pipedrive_rate_limit_remaining gauge\n")
+	fmt.Fprintf(&b, "pipedrive_rate_limit_remaining %d\n", m.rate.Remaining)
+
+	n, err := io.WriteString(w, b.String())
+
+	return int64(n), err
+}
+
+func (k callKey) labels() string {
+	return fmt.Sprintf("method=%q,endpoint=%q", k.method, k.endpoint)
+}
+
+func (k callKey) less(o callKey) bool {
+	if k.endpoint != o.endpoint {
+		return k.endpoint < o.endpoint
+	}
+
+	return k.method < o.method
+}
+
+func sortedCallKeys[V any](m map[callKey]V) []callKey {
+	keys := make([]callKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+
+	return keys
+}
@@ -0,0 +1,75 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	attempts := 0
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.Header().Set("X-RateLimit-Limit", "80")
+		w.Header().Set("X-RateLimit-Remaining", "12")
+		w.Header().Set("X-RateLimit-Reset", "2")
+		if attempts == 1 {
+			w.WriteHeader(503)
+			return
+		}
+		w.WriteHeader(200)
+		w.Write([]byte(`{"success":true,"data":{"id":1}}`))
+	}))
+	defer testAPI.Close()
+
+	metrics := NewPrometheusMetrics()
+	testClient := NewClient(&Config{
+		APIKey:      "1",
+		BaseURL:     testAPI.URL,
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond},
+	})
+	testClient.SetOptions(WithMetrics(metrics))
+
+	err := testClient.GetDeal(context.Background(), 1, &BaseResponse{Data: &BaseDealObject{}})
+	assert.Nil(t, err)
+
+	metricsAPI := httptest.NewServer(metrics)
+	defer metricsAPI.Close()
+
+	resp, err := http.Get(metricsAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	out := string(body)
+
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/plain")
+	assert.Contains(t, out, `pipedrive_request_duration_seconds_count{method="GET",endpoint="/deals/{id}"} 1`)
+	assert.Contains(t, out, `pipedrive_request_duration_seconds_bucket{method="GET",endpoint="/deals/{id}",le="+Inf"} 1`)
+	assert.Contains(t, out, `pipedrive_responses_total{method="GET",endpoint="/deals/{id}",code="200"} 1`)
+	assert.Contains(t, out, `pipedrive_retries_total{method="GET",endpoint="/deals/{id}"} 1`)
+	assert.Contains(t, out, "pipedrive_rate_limit 80\n")
+	assert.Contains(t, out, "pipedrive_rate_limit_remaining 12\n")
+}
+
+func TestHistogramBuckets(t *testing.T) {
+	metrics := NewPrometheusMetrics(1, 0.1)
+	metrics.ObserveCall("GET", "/deals", 200, 50*time.Millisecond)
+	metrics.ObserveCall("GET", "/deals", 200, 500*time.Millisecond)
+	metrics.ObserveCall("GET", "/deals", 500, 5*time.Second)
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	out := rec.Body.String()
+
+	assert.Contains(t, out, `pipedrive_request_duration_seconds_bucket{method="GET",endpoint="/deals",le="0.1"} 1`)
+	assert.Contains(t, out, `pipedrive_request_duration_seconds_bucket{method="GET",endpoint="/deals",le="1"} 2`)
+	assert.Contains(t, out, `pipedrive_request_duration_seconds_bucket{method="GET",endpoint="/deals",le="+Inf"} 3`)
+	assert.Contains(t, out, `pipedrive_responses_total{method="GET",endpoint="/deals",code="500"} 1`)
+}
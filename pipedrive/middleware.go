@@ -0,0 +1,91 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Call describes an API call passing through the middleware chain.
+type Call struct {
+	// Request is the outgoing request. Middleware may modify it, e.g. to
+	// inject headers, before calling the next handler.
+	Request *http.Request
+
+	// Endpoint is the endpoint template of the request with IDs replaced by
+	// a placeholder, e.g. /deals/{id} or /persons/{id}/deals.
+	Endpoint string
+
+	// Out is the value the response body is decoded into, usually a
+	// ResponseModel. It's populated once the next handler returns.
+	Out interface{}
+}
+
+// Handler performs an API call and returns the API response.
+type Handler func(ctx context.Context, call *Call) (*Response, error)
+
+// Middleware wraps a Handler to add behavior around API calls, such as
+// auditing, header injection, fault injection or metrics. The parsed Rate is
+// available on the returned Response and the decoded body on call.Out.
+type Middleware func(next Handler) Handler
+
+// Use appends middleware to the chain around Client.Do. Middleware runs in
+// the order it was added, the first one being the outermost.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// WithMiddleware is an option appending middleware to the client.
+func WithMiddleware(middleware ...Middleware) func(*Client) error {
+	return func(c *Client) error {
+		c.Use(middleware...)
+
+		return nil
+	}
+}
+
+// handler builds the middleware chain around roundTrip.
+func (c *Client) handler() Handler {
+	h := Handler(c.roundTrip)
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+// endpointTemplate returns the API path of u relative to the versioned
+// base URL, with numeric IDs replaced by {id}. Paths of v2 endpoints keep a
+// /v2 prefix, e.g. /v2/deals/{id}.
+func (c *Client) endpointTemplate(u *url.URL) string {
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(c.BaseURL.Path, "/"))
+	path = strings.TrimPrefix(path, "/"+string(APIVersion1))
+	path = strings.TrimPrefix(path, "/api")
+
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if isID(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isID reports whether a path segment is a numeric ID.
+func isID(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
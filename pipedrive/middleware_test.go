@@ -0,0 +1,92 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Test chain order, endpoint and decoded response", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "audit", req.Header.Get("X-Audit"))
+
+			w.Header().Set("X-RateLimit-Limit", "80")
+			w.Header().Set("X-RateLimit-Remaining", "79")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true,"data":{"id":3,"content":"note"}}`))
+		}))
+		defer testAPI.Close()
+
+		testClient := NewClient(&Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+		})
+
+		var order []string
+		testClient.Use(
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) (*Response, error) {
+					order = append(order, "outer")
+					call.Request.Header.Set("X-Audit", "audit")
+
+					return next(ctx, call)
+				}
+			},
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) (*Response, error) {
+					order = append(order, "inner")
+					assert.Equal(t, "/notes/{id}", call.Endpoint)
+
+					resp, err := next(ctx, call)
+					if assert.Nil(t, err) {
+						assert.Equal(t, 79, resp.Rate.Remaining)
+						assert.True(t, call.Out.(ResponseModel).Successful())
+					}
+
+					return resp, err
+				}
+			},
+		)
+
+		outNote := &BaseNoteObject{}
+		err := testClient.GetNote(context.Background(), 3, &BaseResponse{Data: outNote})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"outer", "inner"}, order)
+		assert.Equal(t, 3, outNote.ID)
+	})
+
+	t.Run("Test fault injection", func(t *testing.T) {
+		fault := errors.New("injected")
+		testClient := NewClient(NewConfig("1"))
+		testClient.SetOptions(WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*Response, error) {
+				return nil, fault
+			}
+		}))
+
+		assert.Equal(t, fault, testClient.DeleteDeal(context.Background(), 1))
+	})
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	testClient := NewClient(NewConfig("1"))
+
+	for path, endpoint := range map[string]string{
+		"/deals":                 "/deals",
+		"/deals/search":          "/deals/search",
+		"/deals/12":              "/deals/{id}",
+		"/persons/3/deals":       "/persons/{id}/deals",
+		"/itemSearch/field":      "/itemSearch/field",
+		"/deals/12/products/456": "/deals/{id}/products/{id}",
+	} {
+		req, err := testClient.NewRequest(http.MethodGet, path, nil, nil)
+		if assert.Nil(t, err) {
+			assert.Equal(t, endpoint, testClient.endpointTemplate(req.URL))
+		}
+	}
+}
@@ -15,18 +15,18 @@ type Note interface {
 // BaseNoteObject represents a basic pipedrive note
 type BaseNoteObject struct {
 	// Unsettable Fields
-	ID int `json:"id,omitempty"`
+	ID         int       `json:"id,omitempty"`
+	AddTime    *DateTime `json:"add_time,omitempty"`
+	UpdateTime *DateTime `json:"update_time,omitempty"`
 
 	// Settable Fields
-	UserID   *int    `json:"user_id,omitempty"`
-	DealID   *int    `json:"deal_id,omitempty"`
-	PersonID *int    `json:"person_id,omitempty"`
-	OrgID    *int    `json:"org_id,omitempty"`
-	Content  *string `json:"content,omitempty"`
+	UserID   *int           `json:"user_id,omitempty"`
+	DealID   *Nullable[int] `json:"deal_id,omitempty"`
+	PersonID *Nullable[int] `json:"person_id,omitempty"`
+	OrgID    *Nullable[int] `json:"org_id,omitempty"`
+	Content  *string        `json:"content,omitempty"`
 
 	// Unused Fields
-	// AddTime    Timestamp `json:"add_time,omitempty"`
-	// UpdateTime Timestamp `json:"update_time,omitempty"`
 	// ActiveFlag               bool      `json:"active_flag,omitempty"`
 	// PinnedToDealFlag         bool      `json:"pinned_to_deal_flag,omitempty"`
 	// PinnedToPersonFlag       bool      `json:"pinned_to_person_flag,omitempty"`
@@ -49,7 +49,7 @@ func (c *Client) CreateNote(ctx context.Context, note Note, out ResponseModel) e
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
@@ -70,6 +70,9 @@ func (c *Client) UpdateNote(ctx context.Context, id int, note Note, out Response
 	if err != nil {
 		return err
 	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
 
 	return nil
 }
@@ -91,7 +94,7 @@ func (c *Client) DeleteNote(ctx context.Context, id int) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 	return nil
 }
@@ -112,8 +115,23 @@ func (c *Client) GetNote(ctx context.Context, id int, out ResponseModel) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
 }
+
+// GetNote returns a specific note by id, decoded into T.
+func GetNote[T Note](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/notes/%v", id), nil, nil)
+}
+
+// CreateNote creates a note and returns it decoded into T.
+func CreateNote[T Note](ctx context.Context, c *Client, note Note) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPost, "/notes", nil, note)
+}
+
+// UpdateNote updates a note and returns it decoded into T.
+func UpdateNote[T Note](ctx context.Context, c *Client, id int, note Note) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/notes/%v", id), nil, note)
+}
@@ -0,0 +1,69 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Entity structs are used for partial updates: only the fields that are set
+// are sent. A field can be in one of three states:
+//
+//   - nil: left unchanged, the field is omitted from the request
+//   - set to a value: the field is updated
+//   - cleared: the field is sent as null
+//
+// Fields of type *Nullable[T] are cleared with Null, including references
+// such as *Nullable[OrgID]. The remaining date and time fields such as *Date
+// are cleared with their zero value, e.g. &Date{}.
+
+// Nullable is a value that can be explicitly set to null.
+type Nullable[T any] struct {
+	Value T
+	Valid bool // Valid is false for null
+}
+
+// NewNullable returns a Nullable set to v.
+func NewNullable[T any](v T) *Nullable[T] {
+	return &Nullable[T]{Value: v, Valid: true}
+}
+
+// Null returns a null Nullable, which clears the field it's assigned to.
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{}
+}
+
+// Get returns the value and whether it's set. It's safe to call on nil.
+func (n *Nullable[T]) Get() (T, bool) {
+	if n == nil || !n.Valid {
+		var zero T
+		return zero, false
+	}
+
+	return n.Value, true
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	// Marshal a pointer, so that pointer receivers such as OrgID's are used.
+	return json.Marshal(&n.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = Nullable[T]{}
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullable(t *testing.T) {
+	t.Run("Test partial update of a deal", func(t *testing.T) {
+		title := "Renamed"
+
+		data, err := json.Marshal(&BaseDealObject{Title: &title})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"title":"Renamed"}`, string(data))
+	})
+
+	t.Run("Test clearing deal fields", func(t *testing.T) {
+		data, err := json.Marshal(&BaseDealObject{
+			Value:             Null[float64](),
+			OrgID:             Null[OrgID](),
+			PersonID:          NewNullable(PersonID{ID: 3}),
+			LostReason:        Null[string](),
+			ExpectedCloseDate: Null[Date](),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"value":null,"org_id":null,"person_id":"3","lost_reason":null,"expected_close_date":null}`, string(data))
+	})
+
+	t.Run("Test clearing person and organization fields", func(t *testing.T) {
+		data, err := json.Marshal(&BasePersonObject{LastName: Null[string](), OrgID: Null[OrgID]()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"last_name":null,"org_id":null}`, string(data))
+
+		data, err = json.Marshal(&BaseOrganizationObject{Address: Null[string]()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"address":null}`, string(data))
+	})
+
+	t.Run("Test set, clear and leave unchanged", func(t *testing.T) {
+		data, err := json.Marshal(&BaseNoteObject{
+			DealID:   NewNullable(5),
+			PersonID: Null[int](),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"deal_id":5,"person_id":null}`, string(data))
+	})
+
+	t.Run("Test decoding", func(t *testing.T) {
+		activity := &BaseActivityObject{}
+		err := json.Unmarshal([]byte(`{"deal_id":5,"person_id":null}`), activity)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		id, ok := activity.DealID.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 5, id)
+
+		_, ok = activity.PersonID.Get()
+		assert.False(t, ok)
+		assert.Nil(t, activity.OrgID)
+	})
+}
@@ -0,0 +1,271 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Pipedrive OAuth docs: https://pipedrive.readme.io/docs/marketplace-oauth-authorization
+
+const (
+	DefaultOAuthAuthURL  = "https://oauth.pipedrive.com/oauth/authorize"
+	DefaultOAuthTokenURL = "https://oauth.pipedrive.com/oauth/token"
+
+	// Access tokens are refreshed this long before they actually expire.
+	tokenExpiryDelta = time.Minute
+)
+
+// Token is an OAuth 2.0 token issued by Pipedrive.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+
+	// APIDomain is the company specific API domain, e.g. https://acme.pipedrive.com.
+	APIDomain string `json:"api_domain,omitempty"`
+
+	// Expiry is computed from ExpiresIn when the token is received.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has an access token that hasn't expired.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+
+	return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// TokenSource supplies OAuth tokens to the client.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+// StaticTokenSource returns a TokenSource that always returns the same token.
+func StaticTokenSource(t *Token) TokenSource {
+	return &staticTokenSource{token: t}
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// OAuthConfig describes a Pipedrive OAuth app.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	// AuthURL and TokenURL default to the Pipedrive OAuth server.
+	AuthURL  string
+	TokenURL string
+
+	// HTTPClient is used for token requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// OnTokenRefresh is called with every refreshed token. Pipedrive rotates
+	// refresh tokens, so the new token should be persisted.
+	OnTokenRefresh func(*Token)
+}
+
+// AuthCodeURL returns the URL the user should be redirected to in order to
+// install the app. state is returned unchanged to the redirect URL.
+func (o *OAuthConfig) AuthCodeURL(state string) string {
+	authURL := o.AuthURL
+	if authURL == "" {
+		authURL = DefaultOAuthAuthURL
+	}
+
+	v := url.Values{}
+	v.Set("client_id", o.ClientID)
+
+	if o.RedirectURL != "" {
+		v.Set("redirect_uri", o.RedirectURL)
+	}
+
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange converts an authorization code into a token.
+func (o *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", o.RedirectURL)
+
+	return o.retrieveToken(ctx, v)
+}
+
+// Refresh obtains a new token using the given refresh token.
+func (o *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+
+	return o.retrieveToken(ctx, v)
+}
+
+// TokenSource returns a TokenSource that starts with t and refreshes it
+// when it expires, keeping the rotated refresh token.
+func (o *OAuthConfig) TokenSource(t *Token) TokenSource {
+	return &refreshTokenSource{
+		config: o,
+		token:  t,
+	}
+}
+
+func (o *OAuthConfig) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	tokenURL := o.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultOAuthTokenURL
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(o.ClientID), url.QueryEscape(o.ClientSecret))
+
+	client := o.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("oauth: cannot fetch token: %d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	token := &Token{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oauth: server response missing access_token")
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// refreshTokenSource refreshes its token when it expires. It's safe for
+// concurrent use; only one refresh runs at a time.
+type refreshTokenSource struct {
+	config *OAuthConfig
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func (s *refreshTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, fmt.Errorf("oauth: token expired and refresh token is not set")
+	}
+
+	token, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+
+	if token.APIDomain == "" {
+		token.APIDomain = s.token.APIDomain
+	}
+
+	s.token = token
+
+	if s.config.OnTokenRefresh != nil {
+		s.config.OnTokenRefresh(token)
+	}
+
+	return token, nil
+}
+
+// companyDomainURL builds the API base URL of a company domain, which may be
+// given as "acme", "acme.pipedrive.com" or "https://acme.pipedrive.com".
+func companyDomainURL(domain string) string {
+	domain = strings.TrimSuffix(domain, "/")
+
+	if strings.Contains(domain, "://") {
+		return domain
+	}
+
+	if !strings.Contains(domain, ".") {
+		domain += ".pipedrive.com"
+	}
+
+	return hostProtocol + "://" + domain
+}
+
+// authorizeOAuth sets the OAuth credentials on the request and points it at
+// the company domain of the token.
+func (c *Client) authorizeOAuth(ctx context.Context, req *http.Request) error {
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	if token.APIDomain != "" && req.URL.Host == c.BaseURL.Host {
+		domain, err := url.Parse(companyDomainURL(token.APIDomain))
+		if err != nil {
+			return err
+		}
+
+		req.URL.Scheme = domain.Scheme
+		req.URL.Host = domain.Host
+		req.Host = ""
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	return nil
+}
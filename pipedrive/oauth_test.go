@@ -0,0 +1,131 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthExchange(t *testing.T) {
+	testAuth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client", user)
+		assert.Equal(t, "secret", pass)
+
+		req.ParseForm()
+		assert.Equal(t, "authorization_code", req.PostForm.Get("grant_type"))
+		assert.Equal(t, "abc", req.PostForm.Get("code"))
+		assert.Equal(t, "https://example.com/callback", req.PostForm.Get("redirect_uri"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access1","token_type":"Bearer","refresh_token":"refresh1","scope":"deals:full","expires_in":3599,"api_domain":"https://acme.pipedrive.com"}`))
+	}))
+	defer testAuth.Close()
+
+	config := &OAuthConfig{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		TokenURL:     testAuth.URL,
+	}
+
+	authURL, err := url.Parse(config.AuthCodeURL("xyz"))
+	if assert.Nil(t, err) {
+		assert.Equal(t, "oauth.pipedrive.com", authURL.Host)
+		assert.Equal(t, "client", authURL.Query().Get("client_id"))
+		assert.Equal(t, "xyz", authURL.Query().Get("state"))
+	}
+
+	token, err := config.Exchange(context.Background(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "access1", token.AccessToken)
+	assert.Equal(t, "refresh1", token.RefreshToken)
+	assert.Equal(t, "https://acme.pipedrive.com", token.APIDomain)
+	assert.True(t, token.Valid())
+}
+
+func TestOAuthClient(t *testing.T) {
+	t.Run("Test bearer header and company domain", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Bearer access1", req.Header.Get("Authorization"))
+			assert.Empty(t, req.URL.Query().Get("api_token"))
+			assert.Equal(t, "/v1/deals/1", req.URL.Path)
+
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			BaseURL: DefaultBaseURL,
+			TokenSource: StaticTokenSource(&Token{
+				AccessToken: "access1",
+				APIDomain:   testAPI.URL,
+			}),
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+	})
+
+	t.Run("Test refresh token rotation", func(t *testing.T) {
+		refreshed := []*Token{}
+		testAuth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			req.ParseForm()
+			assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "refresh1", req.PostForm.Get("refresh_token"))
+
+			w.Write([]byte(`{"access_token":"access2","refresh_token":"refresh2","expires_in":3599}`))
+		}))
+		defer testAuth.Close()
+
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Bearer access2", req.Header.Get("Authorization"))
+
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		oauth := &OAuthConfig{
+			ClientID:     "client",
+			ClientSecret: "secret",
+			TokenURL:     testAuth.URL,
+			OnTokenRefresh: func(token *Token) {
+				refreshed = append(refreshed, token)
+			},
+		}
+
+		config := &Config{
+			BaseURL: testAPI.URL,
+			TokenSource: oauth.TokenSource(&Token{
+				AccessToken:  "access1",
+				RefreshToken: "refresh1",
+				Expiry:       time.Now().Add(-time.Minute),
+			}),
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 2))
+
+		if assert.Len(t, refreshed, 1) {
+			assert.Equal(t, "refresh2", refreshed[0].RefreshToken)
+		}
+	})
+}
+
+func TestCompanyDomainURL(t *testing.T) {
+	assert.Equal(t, "https://acme.pipedrive.com", companyDomainURL("acme"))
+	assert.Equal(t, "https://acme.pipedrive.com", companyDomainURL("acme.pipedrive.com"))
+	assert.Equal(t, "https://acme.pipedrive.com", companyDomainURL("https://acme.pipedrive.com/"))
+}
@@ -0,0 +1,105 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Options to be passed to Client.SetOptions.
+
+// WithHTTPClient sets the HTTP client used to communicate with the API.
+func WithHTTPClient(httpClient *http.Client) func(*Client) error {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+
+		c.client = httpClient
+
+		return nil
+	}
+}
+
+// WithTransport sets the transport of the HTTP client, e.g. to configure
+// connection pooling, proxies or TLS. The current HTTP client is copied, so
+// a shared client such as http.DefaultClient is left untouched.
+func WithTransport(transport http.RoundTripper) func(*Client) error {
+	return func(c *Client) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+
+		httpClient := *c.client
+		httpClient.Transport = transport
+		c.client = &httpClient
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) func(*Client) error {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithTimeout sets the default timeout of API calls whose context has no deadline.
+func WithTimeout(timeout time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative, got %v", timeout)
+		}
+
+		c.timeout = timeout
+
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL for API requests.
+func WithBaseURL(baseURL string) func(*Client) error {
+	return func(c *Client) error {
+		u, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/")
+		if err != nil {
+			return err
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("base URL must be absolute, but %q is not", baseURL)
+		}
+
+		c.BaseURL = u
+
+		return nil
+	}
+}
+
+// WithCompanyDomain sets the base URL to a company specific API domain,
+// given as "acme", "acme.pipedrive.com" or "https://acme.pipedrive.com".
+func WithCompanyDomain(domain string) func(*Client) error {
+	return func(c *Client) error {
+		if domain == "" {
+			return errors.New("company domain must not be empty")
+		}
+
+		return WithBaseURL(companyDomainURL(domain))(c)
+	}
+}
+
+// WithActivityTypeValidation checks the Type of activities against the
+// activity types of the company before creating or updating them, so an
+// unknown type fails with an UnknownActivityTypeError without a request.
+func WithActivityTypeValidation(enabled bool) func(*Client) error {
+	return func(c *Client) error {
+		c.validateActivityTypes = enabled
+
+		return nil
+	}
+}
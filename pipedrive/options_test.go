@@ -0,0 +1,89 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSetOptions(t *testing.T) {
+	t.Run("Test user agent and base URL", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "my-app/1.0", req.Header.Get("User-Agent"))
+			assert.Equal(t, "/v1/deals/1", req.URL.Path)
+
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		testClient := NewClient(NewConfig("1"))
+		err := testClient.SetOptions(
+			WithBaseURL(testAPI.URL),
+			WithUserAgent("my-app/1.0"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+	})
+
+	t.Run("Test transport", func(t *testing.T) {
+		called := false
+		testClient := NewClient(NewConfig("1"))
+		err := testClient.SetOptions(WithTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			called = true
+			assert.Equal(t, "api.pipedrive.com", req.URL.Host)
+
+			return &http.Response{
+				StatusCode: 200,
+				Header:     make(http.Header),
+				Body:       http.NoBody,
+				Request:    req,
+			}, nil
+		})))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testClient.DeleteDeal(context.Background(), 1)
+		assert.True(t, called)
+		assert.Nil(t, http.DefaultClient.Transport)
+	})
+
+	t.Run("Test HTTP client, timeout and company domain", func(t *testing.T) {
+		httpClient := &http.Client{}
+		testClient := NewClient(NewConfig("1"))
+		err := testClient.SetOptions(
+			WithHTTPClient(httpClient),
+			WithTimeout(time.Second),
+			WithCompanyDomain("acme"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, httpClient, testClient.client)
+		assert.Equal(t, time.Second, testClient.timeout)
+		assert.Equal(t, "https://acme.pipedrive.com/", testClient.BaseURL.String())
+	})
+
+	t.Run("Test invalid options", func(t *testing.T) {
+		testClient := NewClient(NewConfig("1"))
+
+		assert.NotNil(t, testClient.SetOptions(WithHTTPClient(nil)))
+		assert.NotNil(t, testClient.SetOptions(WithBaseURL("not a url")))
+		assert.NotNil(t, testClient.SetOptions(WithTimeout(-time.Second)))
+	})
+}
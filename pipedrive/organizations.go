@@ -0,0 +1,393 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations
+
+type SearchOrganizationField string
+
+const (
+	SearchOrganizationCustomFields SearchOrganizationField = "custom_fields"
+	SearchOrganizationNotes        SearchOrganizationField = "notes"
+	SearchOrganizationAddress      SearchOrganizationField = "address"
+	SearchOrganizationName         SearchOrganizationField = "name"
+)
+
+//go:generate moq -out mock_organization.go . Organization
+
+// Organization represents a Pipedrive organization.
+// Should embed BaseOrganizationObject
+type Organization interface {
+}
+
+// BaseOrganizationObject represents a basic Pipedrive organization.
+// Nil fields are left unchanged by updates; see Nullable.
+type BaseOrganizationObject struct {
+	// Unsettable Fields
+	ID               int       `json:"id,omitempty"`
+	CompanyID        int       `json:"company_id,omitempty"`
+	ActiveFlag       bool      `json:"active_flag,omitempty"`
+	PeopleCount      int       `json:"people_count,omitempty"`
+	OpenDealsCount   int       `json:"open_deals_count,omitempty"`
+	ClosedDealsCount int       `json:"closed_deals_count,omitempty"`
+	WonDealsCount    int       `json:"won_deals_count,omitempty"`
+	LostDealsCount   int       `json:"lost_deals_count,omitempty"`
+	ActivitiesCount  int       `json:"activities_count,omitempty"`
+	FollowersCount   int       `json:"followers_count,omitempty"`
+	FirstChar        string    `json:"first_char,omitempty"`
+	OwnerName        string    `json:"owner_name,omitempty"`
+	CCEmail          string    `json:"cc_email,omitempty"`
+	AddTime          *DateTime `json:"add_time,omitempty"`
+	UpdateTime       *DateTime `json:"update_time,omitempty"`
+
+	// Settable Fields
+	Name      *string           `json:"name,omitempty"`       // Required
+	OwnerID   *UserID           `json:"owner_id,omitempty"`   // If omitted, the authorized user is the owner
+	VisibleTo *VisibleTo        `json:"visible_to,omitempty"` // If omitted, the default visibility setting of the owner is used
+	Address   *Nullable[string] `json:"address,omitempty"`    // Full address. Pipedrive parses it into address_* fields
+}
+
+// OrganizationFollower is a user following an organization.
+type OrganizationFollower struct {
+	ID      int       `json:"id"`
+	OrgID   int       `json:"org_id"`
+	UserID  int       `json:"user_id"`
+	AddTime *DateTime `json:"add_time,omitempty"`
+}
+
+// CreateOrganization creates an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/post_organizations
+func (c *Client) CreateOrganization(ctx context.Context, org Organization, out ResponseModel) error {
+
+	req, err := c.NewRequest(http.MethodPost, "/organizations", nil, org)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// GetOrganization gets an organization by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id
+func (c *Client) GetOrganization(ctx context.Context, id int, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v", id)
+	req, err := c.NewRequest(http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// UpdateOrganization updates an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/put_organizations_id
+func (c *Client) UpdateOrganization(ctx context.Context, id int, org Organization, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v", id)
+	req, err := c.NewRequest(http.MethodPut, uri, nil, org)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// DeleteOrganization marks an organization as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations_id
+func (c *Client) DeleteOrganization(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/organizations/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+	return nil
+}
+
+// DeleteOrganizations marks multiple organizations as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations
+func (c *Client) DeleteOrganizations(ctx context.Context, ids []int) error {
+	req, err := c.NewRequest(http.MethodDelete, "/organizations", &DeleteMultipleOptions{
+		Ids: arrayToString(ids, ","),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListOrganizationsOptions is used to configure a list organizations request.
+type ListOrganizationsOptions struct {
+	UserID    *int    `url:"user_id,omitempty"`    // If supplied, only organizations owned by the given user will be returned
+	FilterID  *int    `url:"filter_id,omitempty"`  // ID of the filter to use
+	FirstChar *string `url:"first_char,omitempty"` // If supplied, only organizations whose name starts with the specified letter will be returned (case insensitive)
+	Start     *int    `url:"start,omitempty"`      // Pagination start
+	Limit     *int    `url:"limit,omitempty"`      // Items shown per page
+	Sort      *string `url:"sort,omitempty"`       // Field names and sorting mode separated by a comma (field_name_1 ASC, field_name_2 DESC). Only first-level field keys are supported (no nested keys)
+}
+
+// ListOrganizations lists all organizations.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations
+func (c *Client) ListOrganizations(ctx context.Context, opt *ListOrganizationsOptions, out ResponseModel) error {
+	req, err := c.NewRequest(http.MethodGet, "/organizations", opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// SearchOrganizationsOptions is used to configure a search request. Term is required
+type SearchOrganizationsOptions struct {
+	Term       string                   `url:"term"`                  // The search term to look for. Minimum 2 characters (or 1 if using exact_match). (REQUIRED)
+	Fields     *SearchOrganizationField `url:"fields,omitempty"`      // A comma-separated string array. The fields to perform the search from. Defaults to all of them.
+	ExactMatch *bool                    `url:"exact_match,omitempty"` // When enabled, only full exact matches against the given term are returned. It is not case sensitive.
+	Start      *int                     `url:"start,omitempty"`       // Pagination start.
+	Limit      *int                     `url:"limit,omitempty"`       // Items shown per page
+}
+
+// SearchOrganizationsResponse is used to model the search organization response
+type SearchOrganizationsResponse struct {
+	Success   bool              `json:"success,omitempty"`
+	Data      OrganizationItems `json:"data,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	ErrorInfo string            `json:"error_info,omitempty"`
+
+	AdditionalData AdditionalData `json:"additional_data,omitempty"`
+}
+
+// OrganizationItems contains a list of OrganizationItem
+type OrganizationItems struct {
+	Items []OrganizationItem `json:"items,omitempty"`
+}
+
+// OrganizationItem contains a SearchResultOrganization
+type OrganizationItem struct {
+	Organization SearchResultOrganization `json:"item,omitempty"`
+	ResultScore  float64                  `json:"result_score,omitempty"`
+}
+
+// SearchResultOrganization is the model of an organization from the search organization response
+type SearchResultOrganization struct {
+	ID           int                           `json:"id,omitempty"`
+	Type         string                        `json:"type,omitempty"`
+	Name         string                        `json:"name,omitempty"`
+	Address      string                        `json:"address,omitempty"`
+	VisibleTo    int                           `json:"visible_to,omitempty"`
+	Owner        SearchResultOrganizationOwner `json:"owner,omitempty"`
+	CustomFields []string                      `json:"custom_fields,omitempty"`
+	Notes        []string                      `json:"notes,omitempty"`
+}
+
+// SearchResultOrganizationOwner struct
+type SearchResultOrganizationOwner struct {
+	ID int `json:"id,omitempty"`
+}
+
+// SearchOrganizations searches all organizations
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_search
+func (c *Client) SearchOrganizations(ctx context.Context, opt *SearchOrganizationsOptions) (*SearchOrganizationsResponse, error) {
+	req, err := c.NewRequest(http.MethodGet, "/organizations/search", opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &SearchOrganizationsResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return nil, err
+	}
+	if !out.Success {
+		return nil, &UnsuccessfulError{Message: out.Error, ErrorInfo: out.ErrorInfo}
+	}
+
+	return out, nil
+}
+
+// MergeOrganizationsOptions is the body of a merge organizations request.
+type MergeOrganizationsOptions struct {
+	MergeWithID int `json:"merge_with_id"` // ID of the organization that the organization will be merged into. This one is kept
+}
+
+// MergeOrganizations merges the organization with ID id into the one with ID
+// mergeWithID. mergeWithID is kept, with the data of both, and id is deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/put_organizations_id_merge
+func (c *Client) MergeOrganizations(ctx context.Context, id int, mergeWithID int, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v/merge", id)
+	req, err := c.NewRequest(http.MethodPut, uri, nil, &MergeOrganizationsOptions{MergeWithID: mergeWithID})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListOrganizationDeals lists deals associated with an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id_deals
+func (c *Client) ListOrganizationDeals(ctx context.Context, id int, opt *ListDealOptions, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v/deals", id)
+	return c.listOrganizationItems(ctx, uri, opt, out)
+}
+
+// ListOrganizationPersonsOptions is used to configure a list organization persons request.
+type ListOrganizationPersonsOptions struct {
+	Start *int `url:"start,omitempty"` // Pagination start
+	Limit *int `url:"limit,omitempty"` // Items shown per page
+}
+
+// ListOrganizationPersons lists persons of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id_persons
+func (c *Client) ListOrganizationPersons(ctx context.Context, id int, opt *ListOrganizationPersonsOptions, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v/persons", id)
+	return c.listOrganizationItems(ctx, uri, opt, out)
+}
+
+// ListOrganizationActivitiesOptions is used to configure a list organization activities request.
+type ListOrganizationActivitiesOptions struct {
+	Done    *bool   `url:"done,omitempty,int"` // Whether the activity is done or not. If omitted, both done and not done activities are returned
+	Exclude *string `url:"exclude,omitempty"`  // A comma-separated string of activity IDs to exclude from result
+	Start   *int    `url:"start,omitempty"`    // Pagination start
+	Limit   *int    `url:"limit,omitempty"`    // Items shown per page
+}
+
+// ListOrganizationActivities lists activities associated with an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id_activities
+func (c *Client) ListOrganizationActivities(ctx context.Context, id int, opt *ListOrganizationActivitiesOptions, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v/activities", id)
+	return c.listOrganizationItems(ctx, uri, opt, out)
+}
+
+// ListOrganizationFollowers lists followers of an organization. Use
+// OrganizationFollower as the item type of the response.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id_followers
+func (c *Client) ListOrganizationFollowers(ctx context.Context, id int, out ResponseModel) error {
+	uri := fmt.Sprintf("/organizations/%v/followers", id)
+	return c.listOrganizationItems(ctx, uri, nil, out)
+}
+
+func (c *Client) listOrganizationItems(ctx context.Context, uri string, opt interface{}, out ResponseModel) error {
+	req, err := c.NewRequest(http.MethodGet, uri, opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// GetOrganization gets an organization by ID, decoded into T.
+func GetOrganization[T Organization](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/organizations/%v", id), nil, nil)
+}
+
+// CreateOrganization creates an organization and returns it decoded into T.
+func CreateOrganization[T Organization](ctx context.Context, c *Client, org Organization) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPost, "/organizations", nil, org)
+}
+
+// UpdateOrganization updates an organization and returns it decoded into T.
+func UpdateOrganization[T Organization](ctx context.Context, c *Client, id int, org Organization) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/organizations/%v", id), nil, org)
+}
+
+// ListOrganizations lists organizations, decoded into T.
+func ListOrganizations[T Organization](ctx context.Context, c *Client, opt *ListOrganizationsOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, "/organizations", opt)
+}
+
+// ListOrganizationFollowers lists followers of an organization.
+func ListOrganizationFollowers(ctx context.Context, c *Client, id int) ([]OrganizationFollower, *Response, error) {
+	return doData[[]OrganizationFollower](ctx, c, http.MethodGet, fmt.Sprintf("/organizations/%v/followers", id), nil, nil)
+}
+
+// IterateOrganizations returns an Iterator over all organizations, decoded into T.
+func IterateOrganizations[T Organization](c *Client, opt *ListOrganizationsOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, "/organizations", &o.Start, o)
+}
+
+// IterateSearchOrganizations returns an Iterator over the results of an organization search.
+func (c *Client) IterateSearchOrganizations(opt *SearchOrganizationsOptions) *Iterator[OrganizationItem] {
+	o := copyOptions(opt)
+	return searchIterator[OrganizationItem](c, "/organizations/search", o.Term, &o.Start, o)
+}
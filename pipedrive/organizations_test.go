@@ -0,0 +1,168 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizations(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/organizations":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Acme","owner_id":"11535881","visible_to":3}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":1,"company_id":7571105,"owner_id":{"id":11535881,"name":"Tom Shi","email":"tom.shi@societyone.com.au","has_pic":0,"pic_hash":null,"active_flag":true,"value":11535881},"name":"Acme","open_deals_count":0,"people_count":0,"active_flag":true,"first_char":"a","update_time":"2020-06-01 02:41:35","add_time":"2020-06-01 02:41:35","visible_to":"3","cc_email":"acme@pipedrivemail.com","owner_name":"Tom Shi"}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/organizations/1":
+			w.Write([]byte(`{"success":true,"data":{"id":1,"owner_id":11535881,"name":"Acme","people_count":2}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/organizations/1":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"address":"1 Main St"}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":1,"name":"Acme","address":"1 Main St"}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/organizations":
+			assert.Equal(t, "1,2", req.URL.Query().Get("ids"))
+			w.Write([]byte(`{"success":true,"data":[1,2]}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/organizations":
+			assert.Equal(t, "A", req.URL.Query().Get("first_char"))
+			assert.Equal(t, "7", req.URL.Query().Get("filter_id"))
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"name":"Acme"},{"id":2,"name":"Apex"}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/organizations/search":
+			assert.Equal(t, "acme", req.URL.Query().Get("term"))
+			w.Write([]byte(`{"success":true,"data":{"items":[{"result_score":0.9,"item":{"id":1,"type":"organization","name":"Acme","address":null,"visible_to":3,"owner":{"id":11535881},"custom_fields":[],"notes":[]}}]},"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/organizations/1/merge":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"merge_with_id":2}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":2}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/organizations/1/activities":
+			assert.Equal(t, "0", req.URL.Query().Get("done"))
+			w.Write([]byte(`{"success":true,"data":[{"id":4,"subject":"Call"}]}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/organizations/1/followers":
+			w.Write([]byte(`{"success":true,"data":[{"org_id":1,"user_id":11535881,"id":5,"add_time":"2020-06-01 02:41:35"}]}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test create organization", func(t *testing.T) {
+		name := "Acme"
+		visibleTo := VisibleToEntireCompany
+
+		outOrg := &BaseOrganizationObject{}
+		err := testClient.CreateOrganization(context.Background(), &BaseOrganizationObject{
+			Name:      &name,
+			OwnerID:   &UserID{ID: 11535881},
+			VisibleTo: &visibleTo,
+		}, &BaseResponse{Data: outOrg})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 1, outOrg.ID)
+		assert.Equal(t, "Tom Shi", outOrg.OwnerID.Name)
+		assert.Equal(t, 2020, outOrg.AddTime.Year())
+	})
+
+	t.Run("Test get organization", func(t *testing.T) {
+		org, _, err := GetOrganization[*BaseOrganizationObject](context.Background(), testClient, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "Acme", *org.Name)
+		assert.Equal(t, 11535881, org.OwnerID.ID)
+		assert.Equal(t, 2, org.PeopleCount)
+	})
+
+	t.Run("Test update organization", func(t *testing.T) {
+		address := "1 Main St"
+
+		org, _, err := UpdateOrganization[BaseOrganizationObject](context.Background(), testClient, 1, &BaseOrganizationObject{Address: NewNullable(address)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, address, org.Address.Value)
+	})
+
+	t.Run("Test delete organizations", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteOrganizations(context.Background(), []int{1, 2}))
+	})
+
+	t.Run("Test list organizations", func(t *testing.T) {
+		firstChar := "A"
+		filterID := 7
+
+		orgs, err := IterateOrganizations[BaseOrganizationObject](testClient, &ListOrganizationsOptions{
+			FirstChar: &firstChar,
+			FilterID:  &filterID,
+		}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Len(t, orgs, 2)
+		assert.Equal(t, "Apex", *orgs[1].Name)
+	})
+
+	t.Run("Test search organizations", func(t *testing.T) {
+		out, err := testClient.SearchOrganizations(context.Background(), &SearchOrganizationsOptions{Term: "acme"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, out.Data.Items, 1) {
+			assert.Equal(t, "Acme", out.Data.Items[0].Organization.Name)
+			assert.Equal(t, 11535881, out.Data.Items[0].Organization.Owner.ID)
+		}
+	})
+
+	t.Run("Test merge organizations", func(t *testing.T) {
+		merged := &BaseOrganizationObject{}
+		assert.Nil(t, testClient.MergeOrganizations(context.Background(), 1, 2, &BaseResponse{Data: merged}))
+
+		// The organization merged into survives.
+		assert.Equal(t, 2, merged.ID)
+	})
+
+	t.Run("Test list organization activities", func(t *testing.T) {
+		done := false
+		activities := &DataResponse[[]BaseActivityObject]{}
+
+		err := testClient.ListOrganizationActivities(context.Background(), 1, &ListOrganizationActivitiesOptions{Done: &done}, activities)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "Call", *activities.Data[0].Subject)
+	})
+
+	t.Run("Test list organization followers", func(t *testing.T) {
+		followers, _, err := ListOrganizationFollowers(context.Background(), testClient, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, followers, 1) {
+			assert.Equal(t, 11535881, followers[0].UserID)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+var (
+	// ErrIteratorDone is returned by Iterator.Next when there are no more items.
+	ErrIteratorDone = errors.New("pipedrive: no more items in iterator")
+
+	// ErrMaxItemsExceeded is returned by Iterator.All when the collection has
+	// more items than allowed.
+	ErrMaxItemsExceeded = errors.New("pipedrive: collection exceeds max items")
+)
+
+// Page is a single page of an offset paginated collection.
+type Page[T any] struct {
+	Items      []T
+	Pagination Pagination
+}
+
+// PageFunc fetches the page of a collection starting at start.
+type PageFunc[T any] func(ctx context.Context, start int) (*Page[T], error)
+
+// CursorPage is a single page of a cursor paginated collection, as returned
+// by v2 endpoints.
+type CursorPage[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+// CursorPageFunc fetches the page of a collection at cursor. The first page
+// is fetched with an empty cursor.
+type CursorPageFunc[T any] func(ctx context.Context, cursor string) (*CursorPage[T], error)
+
+// Iterator walks a paginated collection, fetching pages lazily.
+// It's not safe for concurrent use.
+type Iterator[T any] struct {
+	fetch func(ctx context.Context) (items []T, more bool, err error)
+	items []T
+	done  bool
+	err   error
+}
+
+// NewIterator returns an Iterator over an offset paginated collection,
+// fetching pages with fetch, starting at start.
+func NewIterator[T any](start int, fetch PageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			page, err := fetch(ctx, start)
+			if err != nil {
+				return nil, false, err
+			}
+
+			if next := page.Pagination.NextStart; next > start {
+				start = next
+			} else {
+				start += len(page.Items)
+			}
+
+			return page.Items, page.Pagination.MoreItemsInCollection, nil
+		},
+	}
+}
+
+// NewCursorIterator returns an Iterator over a cursor paginated collection,
+// fetching pages with fetch until no next cursor is returned.
+func NewCursorIterator[T any](fetch CursorPageFunc[T]) *Iterator[T] {
+	cursor := ""
+
+	return &Iterator[T]{
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			page, err := fetch(ctx, cursor)
+			if err != nil {
+				return nil, false, err
+			}
+
+			cursor = page.NextCursor
+
+			return page.Items, cursor != "", nil
+		},
+	}
+}
+
+// Next returns the next item of the collection. It returns ErrIteratorDone
+// when the collection is exhausted. Once a page fails to load, Next keeps
+// returning that error.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+
+	for len(it.items) == 0 {
+		if it.err != nil {
+			return zero, it.err
+		}
+
+		if it.done {
+			return zero, ErrIteratorDone
+		}
+
+		items, more, err := it.fetch(ctx)
+		if err != nil {
+			it.err = err
+			return zero, err
+		}
+
+		it.items = items
+		it.done = !more || len(items) == 0
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+
+	return item, nil
+}
+
+// All collects the remaining items of the collection. If maxItems is greater
+// than zero and the collection holds more items, the first maxItems items are
+// returned along with ErrMaxItemsExceeded.
+func (it *Iterator[T]) All(ctx context.Context, maxItems int) ([]T, error) {
+	var all []T
+
+	for {
+		item, err := it.Next(ctx)
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return all, err
+		}
+
+		if maxItems > 0 && len(all) == maxItems {
+			return all, fmt.Errorf("%w: more than %d items", ErrMaxItemsExceeded, maxItems)
+		}
+
+		all = append(all, item)
+	}
+}
+
+// Seq2 returns an iter.Seq2 over the remaining items, for use with range.
+// An error is yielded once, as the last element.
+func (it *Iterator[T]) Seq2(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			item, err := it.Next(ctx)
+			if err == ErrIteratorDone {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// failedIterator returns an Iterator that yields err without fetching.
+func failedIterator[T any](err error) *Iterator[T] {
+	return &Iterator[T]{err: err}
+}
+
+// startOf returns the value of an optional start parameter.
+func startOf(start *int) int {
+	if start == nil {
+		return 0
+	}
+
+	return *start
+}
+
+// copyOptions returns a copy of opt, or zero options if opt is nil, so that
+// iterators can page through a collection without changing opt.
+func copyOptions[O any](opt *O) *O {
+	o := new(O)
+	if opt != nil {
+		*o = *opt
+	}
+
+	return o
+}
+
+// listIterator returns an Iterator over an offset paginated v1 list, decoded
+// into T. start points to the Start field of opt, which is set to the start
+// of each page before it's fetched.
+func listIterator[T any](c *Client, uri string, start **int, opt interface{}) *Iterator[T] {
+	return NewIterator(startOf(*start), func(ctx context.Context, s int) (*Page[T], error) {
+		*start = &s
+
+		list, _, err := doList[T](ctx, c, http.MethodGet, uri, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		pagination, err := list.Pagination()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[T]{Items: list.Data, Pagination: pagination}, nil
+	})
+}
+
+// searchIterator returns an Iterator over the results of a v1 search, decoded
+// into T. Without a term it fails without fetching. start points to the Start
+// field of opt, as for listIterator.
+func searchIterator[T any](c *Client, uri, term string, start **int, opt interface{}) *Iterator[T] {
+	if term == "" {
+		return failedIterator[T](ErrMissingSearchTerm)
+	}
+
+	return NewIterator(startOf(*start), func(ctx context.Context, s int) (*Page[T], error) {
+		*start = &s
+
+		out := &DataResponse[struct {
+			Items []T `json:"items"`
+		}]{}
+		if _, err := do(ctx, c, http.MethodGet, uri, opt, nil, out); err != nil {
+			return nil, err
+		}
+
+		var additional AdditionalData
+		if err := out.DecodeAdditionalData(&additional); err != nil {
+			return nil, err
+		}
+
+		return &Page[T]{Items: out.Data.Items, Pagination: additional.Pagination}, nil
+	})
+}
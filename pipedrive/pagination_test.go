@@ -0,0 +1,119 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPagedTestAPI serves total deals in pages of two, both for listing and searching.
+func newPagedTestAPI(total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		end := start + 2
+		if end > total {
+			end = total
+		}
+		more := end < total
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/v1/persons/1/deals":
+			items := ""
+			for i := start; i < end; i++ {
+				if items != "" {
+					items += ","
+				}
+				items += fmt.Sprintf(`{"id":%d}`, i+1)
+			}
+			fmt.Fprintf(w, `{"success":true,"data":[%s],"additional_data":{"pagination":{"start":%d,"limit":2,"more_items_in_collection":%t}}}`, items, start, more)
+		case "/v1/deals/search":
+			items := ""
+			for i := start; i < end; i++ {
+				if items != "" {
+					items += ","
+				}
+				items += fmt.Sprintf(`{"result_score":1,"item":{"id":%d,"title":"deal"}}`, i+1)
+			}
+			fmt.Fprintf(w, `{"success":true,"data":{"items":[%s]},"additional_data":{"pagination":{"start":%d,"limit":2,"more_items_in_collection":%t,"next_start":%d}}}`, items, start, more, end)
+		default:
+			w.WriteHeader(500)
+		}
+	}))
+}
+
+func TestIterator(t *testing.T) {
+	testAPI := newPagedTestAPI(5)
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test next", func(t *testing.T) {
+		it := IterateDeals[BaseDealObject](testClient, 1, nil)
+
+		var ids []int
+		for {
+			deal, err := it.Next(context.Background())
+			if err == ErrIteratorDone {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, deal.ID)
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	})
+
+	t.Run("Test range over search results", func(t *testing.T) {
+		var ids []int
+		for item, err := range testClient.IterateSearchDeals(&SearchDealsOptions{Term: "deal"}).Seq2(context.Background()) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, item.Deal.ID)
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	})
+
+	t.Run("Test search without term", func(t *testing.T) {
+		_, err := testClient.IterateSearchDeals(nil).All(context.Background(), 0)
+		assert.True(t, errors.Is(err, ErrMissingSearchTerm))
+		assert.False(t, errors.Is(err, ErrValidation))
+
+		_, err = IterateItemFields[map[string]interface{}](testClient, &SearchItemFieldsOptions{FieldKey: "title"}).All(context.Background(), 0)
+		assert.Equal(t, ErrMissingSearchTerm, err)
+	})
+
+	t.Run("Test all with max items", func(t *testing.T) {
+		deals, err := IterateDeals[BaseDealObject](testClient, 1, nil).All(context.Background(), 0)
+		assert.Nil(t, err)
+		assert.Len(t, deals, 5)
+
+		deals, err = IterateDeals[BaseDealObject](testClient, 1, nil).All(context.Background(), 5)
+		assert.Nil(t, err)
+		assert.Len(t, deals, 5)
+
+		deals, err = IterateDeals[BaseDealObject](testClient, 1, nil).All(context.Background(), 3)
+		assert.True(t, errors.Is(err, ErrMaxItemsExceeded))
+		assert.Len(t, deals, 3)
+	})
+
+	t.Run("Test errors surface", func(t *testing.T) {
+		it := IterateDeals[BaseDealObject](testClient, 2, nil)
+
+		_, err := it.Next(context.Background())
+		assert.True(t, errors.Is(err, ErrServer))
+
+		_, err = it.Next(context.Background())
+		assert.True(t, errors.Is(err, ErrServer))
+	})
+}
@@ -36,17 +36,20 @@ type Person interface {
 }
 
 // BasePersonObject represents a basic pipedrive person
+// Nil fields are left unchanged by updates; see Nullable.
 type BasePersonObject struct {
 	// Unsettable Fields
-	ID int `json:"id,omitempty" force:"id,omitempty"`
+	ID         int       `json:"id,omitempty" force:"id,omitempty"`
+	AddTime    *DateTime `json:"add_time,omitempty"`
+	UpdateTime *DateTime `json:"update_time,omitempty"`
 
 	// Settable Fields
-	Name      *string  `json:"name,omitempty"`       // Required
-	FirstName *string  `json:"first_name,omitempty"` // Optional
-	LastName  *string  `json:"last_name,omitempty"`  // Optional
-	Phone     []*Phone `json:"phone,omitempty"`
-	Email     []*Email `json:"email,omitempty"`
-	OrgID     *OrgID   `json:"org_id,omitempty"`
+	Name      *string           `json:"name,omitempty"`       // Required
+	FirstName *Nullable[string] `json:"first_name,omitempty"` // Optional
+	LastName  *Nullable[string] `json:"last_name,omitempty"`  // Optional
+	Phone     []*Phone          `json:"phone,omitempty"`
+	Email     []*Email          `json:"email,omitempty"`
+	OrgID     *Nullable[OrgID]  `json:"org_id,omitempty"`
 
 	// Unused Fields
 	// OwnerID                         interface{} `json:"owner_id,omitempty"`
@@ -71,8 +74,6 @@ type BasePersonObject struct {
 	// RelatedLostDealsCount           int         `json:"related_lost_deals_count,omitempty"`
 	// ActiveFlag                      bool        `json:"active_flag,omitempty"`
 	// FirstChar                       string      `json:"first_char,omitempty"`
-	// UpdateTime                      string      `json:"update_time,omitempty"`
-	// AddTime                         string      `json:"add_time,omitempty"`
 	// VisibleTo                       string      `json:"visible_to,omitempty"`
 	// PictureID                       interface{} `json:"picture_id,omitempty"`
 	// NextActivityDate                interface{} `json:"next_activity_date,omitempty"`
@@ -104,7 +105,7 @@ func (c *Client) CreatePerson(ctx context.Context, person Person, out ResponseMo
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
@@ -125,6 +126,9 @@ func (c *Client) UpdatePerson(ctx context.Context, id int, person Person, out Re
 	if err != nil {
 		return err
 	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
 
 	return nil
 }
@@ -146,7 +150,7 @@ func (c *Client) DeletePerson(ctx context.Context, id int) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 	return nil
 }
@@ -168,7 +172,7 @@ func (c *Client) DeletePersons(ctx context.Context, ids []int) error {
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString()) // As of writing this, endpoint may not report errors correctly
+		return unsuccessful(out) // As of writing this, endpoint may not report errors correctly
 	}
 
 	return nil
@@ -191,6 +195,8 @@ type SearchPersonsResponse struct {
 	Data      PersonItems `json:"data,omitempty"`
 	Error     string      `json:"error,omitempty"`
 	ErrorInfo string      `json:"error_info,omitempty"`
+
+	AdditionalData AdditionalData `json:"additional_data,omitempty"`
 }
 
 // PersonItems contains a list of PersonItem
@@ -235,7 +241,7 @@ func (c *Client) SearchPersons(ctx context.Context, opt *SearchPersonsOptions) (
 		return nil, err
 	}
 	if !out.Success {
-		return nil, fmt.Errorf("not successful, error: %v", out.Error)
+		return nil, &UnsuccessfulError{Message: out.Error, ErrorInfo: out.ErrorInfo}
 	}
 
 	return out, nil
@@ -256,8 +262,29 @@ func (c *Client) GetPerson(ctx context.Context, id int, out ResponseModel) error
 		return err
 	}
 	if !out.Successful() {
-		return fmt.Errorf("not successful, error: %v", out.ErrorString())
+		return unsuccessful(out)
 	}
 
 	return nil
 }
+
+// GetPerson returns a person by their id, decoded into T.
+func GetPerson[T Person](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/persons/%v", id), nil, nil)
+}
+
+// CreatePerson creates a person and returns it decoded into T.
+func CreatePerson[T Person](ctx context.Context, c *Client, person Person) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPost, "/persons", nil, person)
+}
+
+// UpdatePerson updates a person and returns it decoded into T.
+func UpdatePerson[T Person](ctx context.Context, c *Client, id int, person Person) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/persons/%v", id), nil, person)
+}
+
+// IterateSearchPersons returns an Iterator over the results of a person search.
+func (c *Client) IterateSearchPersons(opt *SearchPersonsOptions) *Iterator[PersonItem] {
+	o := copyOptions(opt)
+	return searchIterator[PersonItem](c, "/persons/search", o.Term, &o.Start, o)
+}
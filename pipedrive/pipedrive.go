@@ -13,7 +13,6 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -24,6 +23,8 @@ const (
 
 	libraryVersion = "1"
 
+	defaultUserAgent = "pipedrive-api-go/" + libraryVersion
+
 	hostProtocol = "https"
 
 	// The amount of requests current API token can perform for the 10 seconds window.
@@ -34,6 +35,9 @@ const (
 
 	// The amount of seconds before the limit resets.
 	headerRateReset = "X-RateLimit-Reset"
+
+	// Header carrying the API token, which keeps it out of request URLs.
+	headerAPIToken = "x-api-token"
 )
 
 type Client struct {
@@ -46,8 +50,41 @@ type Client struct {
 	BaseURL *url.URL
 	apiKey  string
 
-	rateMutex   sync.Mutex
-	currentRate Rate
+	// User agent used when communicating with the API.
+	userAgent string
+
+	// OAuth token source. When set, it's used instead of apiKey.
+	tokenSource TokenSource
+
+	// Default timeout applied to requests whose context carries no deadline.
+	// Zero means no timeout.
+	timeout time.Duration
+
+	// Policy used to retry transient failures. Nil disables retries.
+	retryPolicy *RetryPolicy
+
+	// Token bucket tracking the rate limit reported by the API.
+	rate          rateLimiter
+	rateLimitMode RateLimitMode
+
+	// Logger receiving a record of every API call. Nil disables logging.
+	logger       Logger
+	logBodies    bool
+	redactFields map[string]bool
+
+	// Metrics recorder. Nil disables metrics.
+	metrics Metrics
+
+	// Middleware wrapped around every call to Do.
+	middleware []Middleware
+
+	// Field definitions used to map custom fields by name.
+	fieldSets fieldSetCache
+
+	// Active activity types, checked against the Type of activities when
+	// validateActivityTypes is set.
+	activityTypes         activityTypeCache
+	validateActivityTypes bool
 
 	// Reuse a single struct instead of allocating one for each service.
 	common service
@@ -85,9 +122,28 @@ type service struct {
 }
 
 type Config struct {
-	APIKey        string
-	BaseURL       string
+	APIKey  string
+	BaseURL string
+
+	// CompanyDomain is the company specific API domain, such as the
+	// api_domain of an OAuth token. When set, it takes precedence over BaseURL.
 	CompanyDomain string
+
+	// TokenSource enables OAuth authentication. Requests are sent with an
+	// Authorization: Bearer header instead of the API token.
+	TokenSource TokenSource
+
+	// Timeout is the default timeout for a single API call. It only applies
+	// when the context passed to a service method has no deadline of its own.
+	Timeout time.Duration
+
+	// RetryPolicy enables automatic retries of transient failures.
+	// Nil disables retries.
+	RetryPolicy *RetryPolicy
+
+	// RateLimitMode selects whether an exhausted rate limit fails fast
+	// (the default) or waits for the window to reset.
+	RateLimitMode RateLimitMode
 }
 
 type Rate struct {
@@ -118,20 +174,34 @@ func parseRateFromResponse(r *http.Response) Rate {
 	}
 
 	if reset := r.Header.Get(headerRateReset); reset != "" {
-		if value, _ := strconv.ParseInt(reset, 10, 64); value != 0 {
-			rate.Reset = Timestamp{time.Unix(value, 0)}
+		if value, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			rate.Reset = Timestamp{time.Now().Add(time.Duration(value) * time.Second)}
 		}
 	}
 
 	return rate
 }
 
+// APIVersion selects the version of the Pipedrive API an endpoint belongs to.
+type APIVersion string
+
+const (
+	APIVersion1 APIVersion = "v" + libraryVersion
+	APIVersion2 APIVersion = "api/v2"
+)
+
+// NewRequest creates a request for a v1 endpoint.
 func (c *Client) NewRequest(method, url string, opt interface{}, body interface{}) (*http.Request, error) {
+	return c.NewVersionedRequest(APIVersion1, method, url, opt, body)
+}
+
+// NewVersionedRequest creates a request for an endpoint of the given API version.
+func (c *Client) NewVersionedRequest(version APIVersion, method, url string, opt interface{}, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
-	u, err := c.createRequestUrl(url, opt)
+	u, err := c.createRequestUrl(version, url, opt)
 
 	if err != nil {
 		return nil, err
@@ -160,15 +230,19 @@ func (c *Client) NewRequest(method, url string, opt interface{}, body interface{
 		request.Header.Set("Content-Type", "application/json")
 	}
 
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
+
 	return request, nil
 }
 
 func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
-	c.rateMutex.Lock()
-	rate := c.currentRate
-	c.rateMutex.Unlock()
+	if c.rateLimitMode != RateLimitFailFast {
+		return nil
+	}
 
-	if !rate.Reset.Time.IsZero() && rate.Remaining == 0 {
+	if rate, exhausted := c.rate.exhausted(); exhausted {
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
 			StatusCode: http.StatusForbidden,
@@ -188,25 +262,28 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 }
 
 func (c *Client) checkResponse(r *http.Response) error {
-	if code := r.StatusCode; (200 <= code && code <= 299) || code == 410 { // 410 for deleting already deleted object
+	if code := r.StatusCode; 200 <= code && code <= 299 {
 		return nil
 	}
 
-	data, err := ioutil.ReadAll(r.Body)
-	errorResponse := &ErrorResponse{Response: r}
+	// 410 for deleting already deleted object
+	if r.StatusCode == http.StatusGone && r.Request != nil && r.Request.Method == http.MethodDelete {
+		return nil
+	}
 
-	if err == nil && data != nil {
-		err = json.Unmarshal(data, errorResponse)
-		if err != nil {
-			return err
-		}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
 	}
 
+	errorResponse := newErrorResponse(r, data)
+
 	switch {
 	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
 		return &RateLimitError{
 			Rate:     parseRateFromResponse(r),
 			Response: errorResponse.Response,
+			Message:  errorResponse.ErrorMessage(),
 		}
 
 	default:
@@ -214,64 +291,121 @@ func (c *Client) checkResponse(r *http.Response) error {
 	}
 }
 
-// Do sends an API request and returns the API response. T
+// Do sends an API request through the middleware chain and returns the API
+// response.
 //
-// The provided ctx must be non-nil. If it is canceled or times out,
-// ctx.Err() will be returned.
+// The provided ctx must be non-nil. It is attached to the request, so
+// canceling it aborts the call in flight. If the context has no deadline
+// and the client has a default timeout, the timeout is applied. If ctx is
+// canceled or times out, ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, request *http.Request, out interface{}) (*Response, error) {
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	call := &Call{
+		Request:  request.Clone(ctx),
+		Endpoint: c.endpointTemplate(request.URL),
+		Out:      out,
+	}
+
+	return c.handler()(ctx, call)
+}
+
+// roundTrip is the innermost Handler of the middleware chain. It performs
+// the call and reports it to the logger and metrics.
+func (c *Client) roundTrip(ctx context.Context, call *Call) (*Response, error) {
+	if c.logger == nil && c.metrics == nil {
+		response, _, err := c.exchange(ctx, call)
+		return response, err
+	}
+
+	start := time.Now()
+	response, body, err := c.exchange(ctx, call)
+	duration := time.Since(start)
+
+	if c.metrics != nil {
+		status := 0
+		if response != nil && response.Response != nil {
+			status = response.StatusCode
+		}
+
+		c.metrics.ObserveCall(call.Request.Method, call.Endpoint, status, duration)
+	}
+
+	if c.logger != nil {
+		c.logCall(ctx, call, response, body, err, duration)
+	}
+
+	return response, err
+}
+
+// exchange authorizes and sends the request, then decodes the response into
+// call.Out. The raw response body is returned alongside.
+func (c *Client) exchange(ctx context.Context, call *Call) (*Response, []byte, error) {
+	request, out := call.Request, call.Out
+
+	if err := c.authorize(ctx, request); err != nil {
+		return nil, nil, err
+	}
+
 	if err := c.checkRateLimitBeforeDo(request); err != nil {
 		return &Response{
 			Response: err.Response,
-		}, err
+		}, nil, err
 	}
 
-	resp, err := c.client.Do(request)
+	resp, err := c.doWithRetry(ctx, request)
 	if err != nil {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 		}
 
-		return nil, err
+		if e, ok := err.(*url.Error); ok {
+			e.URL = redactURL(e.URL)
+		}
+
+		return nil, nil, err
 	}
 
+	body := resp.Body
 	defer func() {
-		io.CopyN(ioutil.Discard, resp.Body, 512)
-		resp.Body.Close()
+		io.CopyN(ioutil.Discard, body, 512)
+		body.Close()
 	}()
 
 	response := newResponse(resp)
 
-	c.rateMutex.Lock()
-	c.currentRate = response.Rate
-	c.rateMutex.Unlock()
-
-	err = c.checkResponse(response.Response)
+	respBytes, err := ioutil.ReadAll(body)
 	if err != nil {
-		return response, err
+		return response, nil, err
 	}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
+	// Let checkResponse read the body again.
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBytes))
+
+	err = c.checkResponse(response.Response)
 	if err != nil {
-		return response, err
+		return response, respBytes, err
 	}
 
-	// fmt.Println(string(respBytes))
-
 	if out != nil {
 		err = json.Unmarshal(respBytes, out)
 		if err != nil {
-			return response, err
+			return response, respBytes, err
 		}
 
-		return response, nil
+		return response, respBytes, nil
 	}
-	return response, errors.New("Expected output struct 'out' is not provided")
+	return response, respBytes, errors.New("Expected output struct 'out' is not provided")
 }
 
-func (c *Client) createRequestUrl(path string, opt interface{}) (string, error) {
-	uri, err := c.BaseURL.Parse(c.BaseURL.String() + "v" + libraryVersion)
+func (c *Client) createRequestUrl(version APIVersion, path string, opt interface{}) (string, error) {
+	uri, err := c.BaseURL.Parse(c.BaseURL.String() + string(version))
 
 	if err != nil {
 		return path, err
@@ -281,12 +415,7 @@ func (c *Client) createRequestUrl(path string, opt interface{}) (string, error)
 
 	v := reflect.ValueOf(opt)
 
-	if v.Kind() == reflect.Ptr && v.IsNil() {
-		parameters := url.Values{}
-		parameters.Add("api_token", c.apiKey)
-
-		uri.RawQuery = parameters.Encode()
-
+	if opt == nil || v.Kind() == reflect.Ptr && v.IsNil() {
 		return uri.String(), nil
 	}
 
@@ -296,8 +425,6 @@ func (c *Client) createRequestUrl(path string, opt interface{}) (string, error)
 		return path, err
 	}
 
-	qs.Add("api_token", c.apiKey)
-
 	uri.RawQuery = qs.Encode()
 
 	return uri.String(), nil
@@ -321,12 +448,23 @@ func NewConfig(apiKey string) *Config {
 }
 
 func NewClient(options *Config) *Client {
-	baseURL, _ := url.Parse(options.BaseURL + "/")
+	rawURL := options.BaseURL
+	if options.CompanyDomain != "" {
+		rawURL = companyDomainURL(options.CompanyDomain)
+	}
+
+	baseURL, _ := url.Parse(rawURL + "/")
 
 	c := &Client{
-		client:  http.DefaultClient,
-		BaseURL: baseURL,
-		apiKey:  options.APIKey,
+		client:        http.DefaultClient,
+		BaseURL:       baseURL,
+		apiKey:        options.APIKey,
+		userAgent:     defaultUserAgent,
+		redactFields:  newRedactFields(defaultRedactFields),
+		tokenSource:   options.TokenSource,
+		timeout:       options.Timeout,
+		retryPolicy:   options.RetryPolicy,
+		rateLimitMode: options.RateLimitMode,
 	}
 
 	// c.common.client = c
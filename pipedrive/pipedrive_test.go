@@ -0,0 +1,68 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newHangingTestClient returns a client of a server that doesn't respond
+// until the request is canceled or done is called.
+func newHangingTestClient(t *testing.T, timeout time.Duration) (*Client, func()) {
+	t.Helper()
+
+	release := make(chan struct{})
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-release:
+		case <-req.Context().Done():
+		}
+	}))
+
+	config := &Config{
+		APIKey:  "1",
+		BaseURL: testAPI.URL,
+		Timeout: timeout,
+	}
+
+	return NewClient(config), func() {
+		close(release)
+		testAPI.Close()
+	}
+}
+
+func TestDoContext(t *testing.T) {
+	t.Run("Test cancel in-flight request", func(t *testing.T) {
+		testClient, done := newHangingTestClient(t, 0)
+		defer done()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		err := testClient.GetNote(ctx, 1, &BaseResponse{Data: &BaseNoteObject{}})
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("Test per call deadline", func(t *testing.T) {
+		testClient, done := newHangingTestClient(t, 0)
+		defer done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := testClient.DeleteDeal(ctx, 1)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("Test client default timeout", func(t *testing.T) {
+		testClient, done := newHangingTestClient(t, 50*time.Millisecond)
+		defer done()
+
+		_, err := testClient.SearchPersons(context.Background(), &SearchPersonsOptions{Term: "test"})
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
@@ -0,0 +1,228 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines
+
+// Pipeline is an ordered set of stages that deals move through.
+// Nil fields are left unchanged by updates.
+type Pipeline struct {
+	// Unsettable Fields
+	ID         int       `json:"id,omitempty"`
+	URLTitle   string    `json:"url_title,omitempty"`
+	Selected   bool      `json:"selected,omitempty"`
+	AddTime    *DateTime `json:"add_time,omitempty"`
+	UpdateTime *DateTime `json:"update_time,omitempty"`
+
+	// Settable Fields
+	Name            *string `json:"name,omitempty"`             // Required when creating
+	DealProbability *bool   `json:"deal_probability,omitempty"` // Whether deal probability is disabled or enabled for this pipeline
+	OrderNr         *int    `json:"order_nr,omitempty"`         // Defines pipelines order. First order (order_nr=0) is the default pipeline
+	Active          *bool   `json:"active,omitempty"`           // Whether this pipeline will be made inactive (hidden) or active
+}
+
+// ListPipelines lists all pipelines.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines
+func (c *Client) ListPipelines(ctx context.Context) ([]Pipeline, error) {
+	pipelines, _, err := doData[[]Pipeline](ctx, c, http.MethodGet, "/pipelines", nil, nil)
+	return pipelines, err
+}
+
+// GetPipeline gets a pipeline by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id
+func (c *Client) GetPipeline(ctx context.Context, id int) (*Pipeline, error) {
+	pipeline, _, err := doData[*Pipeline](ctx, c, http.MethodGet, fmt.Sprintf("/pipelines/%v", id), nil, nil)
+	return pipeline, err
+}
+
+// CreatePipeline creates a pipeline. Name is required.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/post_pipelines
+func (c *Client) CreatePipeline(ctx context.Context, pipeline *Pipeline) (*Pipeline, error) {
+	created, _, err := doData[*Pipeline](ctx, c, http.MethodPost, "/pipelines", nil, pipeline)
+	return created, err
+}
+
+// UpdatePipeline updates a pipeline.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/put_pipelines_id
+func (c *Client) UpdatePipeline(ctx context.Context, id int, pipeline *Pipeline) (*Pipeline, error) {
+	updated, _, err := doData[*Pipeline](ctx, c, http.MethodPut, fmt.Sprintf("/pipelines/%v", id), nil, pipeline)
+	return updated, err
+}
+
+// DeletePipeline marks a pipeline as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/delete_pipelines_id
+func (c *Client) DeletePipeline(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/pipelines/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListPipelineDealsOptions is used to configure a list pipeline deals request.
+type ListPipelineDealsOptions struct {
+	FilterID              *int    `url:"filter_id,omitempty"`               // If supplied, only deals matching the given filter will be returned
+	UserID                *int    `url:"user_id,omitempty"`                 // If supplied, filter_id will not be considered and only deals owned by the given user will be returned. If omitted, deals owned by the authorized user will be returned
+	Everyone              *bool   `url:"everyone,omitempty,int"`            // If supplied, filter_id and user_id will not be considered – instead, deals owned by everyone will be returned
+	StageID               *int    `url:"stage_id,omitempty"`                // If supplied, only deals within the given stage will be returned
+	TotalsConvertCurrency *string `url:"totals_convert_currency,omitempty"` // The 3-letter currency code of any of the supported currencies. When supplied, per_stages_converted is returned in deals_summary
+	Start                 *int    `url:"start,omitempty"`                   // Pagination start
+	Limit                 *int    `url:"limit,omitempty"`                   // Items shown per page
+}
+
+// ListPipelineDeals lists deals in a pipeline.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_deals
+func (c *Client) ListPipelineDeals(ctx context.Context, id int, opt *ListPipelineDealsOptions, out ResponseModel) error {
+	uri := fmt.Sprintf("/pipelines/%v/deals", id)
+	req, err := c.NewRequest(http.MethodGet, uri, opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// PipelineStatisticsOptions is used to configure a pipeline statistics request.
+// StartDate and EndDate are required.
+type PipelineStatisticsOptions struct {
+	StartDate *Date `url:"start_date,omitempty"` // The start of the period
+	EndDate   *Date `url:"end_date,omitempty"`   // The end of the period
+	UserID    *int  `url:"user_id,omitempty"`    // The user who's statistics to fetch. If omitted, statistics for all company users will be fetched
+}
+
+// StageConversion is the rate of deals moving from one stage to the next.
+type StageConversion struct {
+	FromStageID    int     `json:"from_stage_id"`
+	ToStageID      int     `json:"to_stage_id"`
+	ConversionRate float64 `json:"conversion_rate"` // Percentage
+}
+
+// PipelineConversionStatistics are the stage-to-stage, won and lost
+// conversion rates of a pipeline over a period.
+type PipelineConversionStatistics struct {
+	StageConversions []StageConversion `json:"stage_conversions"`
+	WonConversion    float64           `json:"won_conversion"`  // Percentage
+	LostConversion   float64           `json:"lost_conversion"` // Percentage
+}
+
+// GetPipelineConversionStatistics gets the conversion rates of a pipeline.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_conversion_statistics
+func (c *Client) GetPipelineConversionStatistics(ctx context.Context, id int, opt *PipelineStatisticsOptions) (*PipelineConversionStatistics, error) {
+	uri := fmt.Sprintf("/pipelines/%v/conversion_statistics", id)
+	stats, _, err := doData[*PipelineConversionStatistics](ctx, c, http.MethodGet, uri, opt, nil)
+	return stats, err
+}
+
+// DealMovements are the deals of a movement statistic.
+type DealMovements struct {
+	Count           int                `json:"count"`
+	DealsIDs        []int              `json:"deals_ids"`
+	Values          map[string]float64 `json:"values"`           // Total value by currency code
+	FormattedValues map[string]string  `json:"formatted_values"` // Formatted total value by currency code
+}
+
+// StageAge is the average age of the deals in a stage.
+type StageAge struct {
+	StageID int     `json:"stage_id"`
+	Value   float64 `json:"value"` // Days
+}
+
+// DealAge is the average age of deals in a pipeline.
+type DealAge struct {
+	AcrossAllStages float64    `json:"across_all_stages"` // Days
+	ByStages        []StageAge `json:"by_stages"`
+}
+
+// PipelineMovementStatistics are the deal movements of a pipeline over a period.
+type PipelineMovementStatistics struct {
+	MovementsBetweenStages struct {
+		Count int `json:"count"`
+	} `json:"movements_between_stages"`
+	NewDeals         DealMovements `json:"new_deals"`
+	DealsLeftOpen    DealMovements `json:"deals_left_open"`
+	WonDeals         DealMovements `json:"won_deals"`
+	LostDeals        DealMovements `json:"lost_deals"`
+	AverageAgeInDays DealAge       `json:"average_age_in_days"`
+}
+
+// GetPipelineMovementStatistics gets the deal movements of a pipeline.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_movement_statistics
+func (c *Client) GetPipelineMovementStatistics(ctx context.Context, id int, opt *PipelineStatisticsOptions) (*PipelineMovementStatistics, error) {
+	uri := fmt.Sprintf("/pipelines/%v/movement_statistics", id)
+	stats, _, err := doData[*PipelineMovementStatistics](ctx, c, http.MethodGet, uri, opt, nil)
+	return stats, err
+}
+
+// FindStage finds a stage by the name of its pipeline and its own name.
+// Names are compared case-insensitively. The error matches ErrNotFound if
+// either doesn't exist.
+func (c *Client) FindStage(ctx context.Context, pipelineName, stageName string) (*Stage, error) {
+	pipelines, err := c.ListPipelines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pipelines {
+		if p.Name == nil || !strings.EqualFold(*p.Name, pipelineName) {
+			continue
+		}
+
+		stages, err := c.ListStages(ctx, &ListStagesOptions{PipelineID: &p.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range stages {
+			if stages[i].Name != nil && strings.EqualFold(*stages[i].Name, stageName) {
+				return &stages[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: stage %q of pipeline %q", ErrNotFound, stageName, pipelineName)
+	}
+
+	return nil, fmt.Errorf("%w: pipeline %q", ErrNotFound, pipelineName)
+}
+
+// ListPipelineDeals lists deals in a pipeline, decoded into T.
+func ListPipelineDeals[T Deal](ctx context.Context, c *Client, id int, opt *ListPipelineDealsOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, fmt.Sprintf("/pipelines/%v/deals", id), opt)
+}
+
+// IteratePipelineDeals returns an Iterator over the deals in a pipeline, decoded into T.
+func IteratePipelineDeals[T Deal](c *Client, id int, opt *ListPipelineDealsOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, fmt.Sprintf("/pipelines/%v/deals", id), &o.Start, o)
+}
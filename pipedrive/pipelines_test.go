@@ -0,0 +1,149 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelines(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/pipelines":
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"name":"Sales","url_title":"sales","order_nr":0,"active":true,"deal_probability":true,"add_time":"2020-06-01 02:41:35","update_time":null,"selected":true},{"id":2,"name":"Partnerships","url_title":"partnerships","order_nr":1,"active":true,"deal_probability":false,"selected":false}]}`))
+
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/pipelines":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Renewals","active":false}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":3,"name":"Renewals","url_title":"renewals","order_nr":2,"active":false}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/stages":
+			assert.Equal(t, "1", req.URL.Query().Get("pipeline_id"))
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"order_nr":1,"name":"Qualified","active_flag":true,"deal_probability":100,"pipeline_id":1,"rotten_flag":false,"rotten_days":null,"pipeline_name":"Sales"},{"id":2,"order_nr":2,"name":"Proposal Made","active_flag":true,"deal_probability":100,"pipeline_id":1,"rotten_flag":true,"rotten_days":14,"pipeline_name":"Sales"}]}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/pipelines/1/deals":
+			assert.Equal(t, "1", req.URL.Query().Get("everyone"))
+			assert.Equal(t, "2", req.URL.Query().Get("stage_id"))
+			w.Write([]byte(`{"success":true,"data":[{"id":16143,"title":"Big deal","stage_id":2,"pipeline_id":1}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/pipelines/1/conversion_statistics":
+			assert.Equal(t, "2020-06-01", req.URL.Query().Get("start_date"))
+			assert.Equal(t, "2020-06-30", req.URL.Query().Get("end_date"))
+			w.Write([]byte(`{"success":true,"data":{"stage_conversions":[{"from_stage_id":1,"to_stage_id":2,"conversion_rate":50}],"won_conversion":25.5,"lost_conversion":74.5}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/pipelines/1/movement_statistics":
+			assert.Equal(t, "11535881", req.URL.Query().Get("user_id"))
+			w.Write([]byte(`{"success":true,"data":{"movements_between_stages":{"count":3},"new_deals":{"count":2,"deals_ids":[16143,16144],"values":{"AUD":1500},"formatted_values":{"AUD":"A$1,500"}},"deals_left_open":{"count":1,"deals_ids":[16144],"values":{"AUD":500},"formatted_values":{"AUD":"A$500"}},"won_deals":{"count":1,"deals_ids":[16143],"values":{"AUD":1000},"formatted_values":{"AUD":"A$1,000"}},"lost_deals":{"count":0,"deals_ids":[],"values":{},"formatted_values":{}},"average_age_in_days":{"across_all_stages":4.5,"by_stages":[{"stage_id":1,"value":2},{"stage_id":2,"value":7}]}}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test list pipelines", func(t *testing.T) {
+		pipelines, err := testClient.ListPipelines(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, pipelines, 2) {
+			assert.Equal(t, "Sales", *pipelines[0].Name)
+			assert.True(t, *pipelines[0].DealProbability)
+			assert.True(t, pipelines[0].Selected)
+			assert.Equal(t, 1, *pipelines[1].OrderNr)
+		}
+	})
+
+	t.Run("Test create pipeline", func(t *testing.T) {
+		name := "Renewals"
+		active := false
+		pipeline, err := testClient.CreatePipeline(context.Background(), &Pipeline{Name: &name, Active: &active})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 3, pipeline.ID)
+		assert.Equal(t, "renewals", pipeline.URLTitle)
+	})
+
+	t.Run("Test find stage", func(t *testing.T) {
+		stage, err := testClient.FindStage(context.Background(), "sales", "Proposal made")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 2, stage.ID)
+		rottenDays, _ := stage.RottenDays.Get()
+		assert.Equal(t, 14, rottenDays)
+
+		_, err = testClient.FindStage(context.Background(), "Sales", "Won")
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Equal(t, `pipedrive: not found: stage "Won" of pipeline "Sales"`, err.Error())
+
+		_, err = testClient.FindStage(context.Background(), "Support", "Open")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("Test iterate pipeline deals", func(t *testing.T) {
+		everyone := true
+		stageID := 2
+		deals, err := IteratePipelineDeals[BaseDealObject](testClient, 1, &ListPipelineDealsOptions{
+			Everyone: &everyone,
+			StageID:  &stageID,
+		}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, deals, 1) {
+			assert.Equal(t, 16143, deals[0].ID)
+			assert.Equal(t, 2, *deals[0].StageID)
+		}
+	})
+
+	t.Run("Test get conversion statistics", func(t *testing.T) {
+		stats, err := testClient.GetPipelineConversionStatistics(context.Background(), 1, &PipelineStatisticsOptions{
+			StartDate: NewDate(2020, time.June, 1),
+			EndDate:   NewDate(2020, time.June, 30),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []StageConversion{{FromStageID: 1, ToStageID: 2, ConversionRate: 50}}, stats.StageConversions)
+		assert.Equal(t, 25.5, stats.WonConversion)
+		assert.Equal(t, 74.5, stats.LostConversion)
+	})
+
+	t.Run("Test get movement statistics", func(t *testing.T) {
+		userID := 11535881
+		stats, err := testClient.GetPipelineMovementStatistics(context.Background(), 1, &PipelineStatisticsOptions{
+			StartDate: NewDate(2020, time.June, 1),
+			EndDate:   NewDate(2020, time.June, 30),
+			UserID:    &userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 3, stats.MovementsBetweenStages.Count)
+		assert.Equal(t, []int{16143, 16144}, stats.NewDeals.DealsIDs)
+		assert.Equal(t, 1000.0, stats.WonDeals.Values["AUD"])
+		assert.Equal(t, "A$500", stats.DealsLeftOpen.FormattedValues["AUD"])
+		assert.Equal(t, 0, stats.LostDeals.Count)
+		assert.Equal(t, 4.5, stats.AverageAgeInDays.AcrossAllStages)
+		assert.Equal(t, StageAge{StageID: 2, Value: 7}, stats.AverageAgeInDays.ByStages[1])
+	})
+}
@@ -0,0 +1,365 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products
+
+type SearchProductField string
+
+const (
+	SearchProductCustomFields SearchProductField = "custom_fields"
+	SearchProductName         SearchProductField = "name"
+	SearchProductCode         SearchProductField = "code"
+)
+
+//go:generate moq -out mock_product.go . Product
+
+// Product represents a Pipedrive product.
+// Should embed BaseProductObject
+type Product interface {
+}
+
+// ProductPrice is the price of a product in one currency.
+type ProductPrice struct {
+	// Unsettable Fields
+	ID        int `json:"id,omitempty"`
+	ProductID int `json:"product_id,omitempty"`
+
+	// Settable Fields
+	Currency     string   `json:"currency"`                // 3-letter currency code
+	Price        float64  `json:"price"`                   // Unit price
+	Cost         *float64 `json:"cost,omitempty"`          // Unit cost
+	OverheadCost *float64 `json:"overhead_cost,omitempty"` // Overhead cost
+}
+
+// BaseProductObject represents a basic Pipedrive product.
+// Nil fields are left unchanged by updates; see Nullable.
+type BaseProductObject struct {
+	// Unsettable Fields
+	ID             int       `json:"id,omitempty"`
+	FilesCount     int       `json:"files_count,omitempty"`
+	FollowersCount int       `json:"followers_count,omitempty"`
+	FirstChar      string    `json:"first_char,omitempty"`
+	AddTime        *DateTime `json:"add_time,omitempty"`
+	UpdateTime     *DateTime `json:"update_time,omitempty"`
+
+	// Settable Fields
+	Name        *string        `json:"name,omitempty"`        // Required
+	Code        *string        `json:"code,omitempty"`        // Product code
+	Description *string        `json:"description,omitempty"` // Description of the product
+	Unit        *string        `json:"unit,omitempty"`        // Unit in which this product is sold
+	Tax         *float64       `json:"tax,omitempty"`         // Tax percentage
+	ActiveFlag  *bool          `json:"active_flag,omitempty"` // Whether this product will be made active or not
+	Selectable  *bool          `json:"selectable,omitempty"`  // Whether this product can be selected in deals or not
+	OwnerID     *UserID        `json:"owner_id,omitempty"`    // If omitted, the authorized user is the owner
+	VisibleTo   *VisibleTo     `json:"visible_to,omitempty"`  // If omitted, the default visibility setting of the owner is used
+	Prices      []ProductPrice `json:"prices,omitempty"`      // Prices of the product, one per currency. Replaces all prices on update
+}
+
+// Price returns the price of the product in a currency.
+func (p *BaseProductObject) Price(currency string) (ProductPrice, bool) {
+	for _, price := range p.Prices {
+		if price.Currency == currency {
+			return price, true
+		}
+	}
+
+	return ProductPrice{}, false
+}
+
+// SetPrice sets the price of the product in the currency of price, adding
+// it if the product has no price in that currency.
+func (p *BaseProductObject) SetPrice(price ProductPrice) {
+	for i := range p.Prices {
+		if p.Prices[i].Currency == price.Currency {
+			p.Prices[i] = price
+			return
+		}
+	}
+
+	p.Prices = append(p.Prices, price)
+}
+
+// CreateProduct creates a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/post_products
+func (c *Client) CreateProduct(ctx context.Context, product Product, out ResponseModel) error {
+	req, err := c.NewRequest(http.MethodPost, "/products", nil, product)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// GetProduct gets a product by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id
+func (c *Client) GetProduct(ctx context.Context, id int, out ResponseModel) error {
+	uri := fmt.Sprintf("/products/%v", id)
+	req, err := c.NewRequest(http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// UpdateProduct updates a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/put_products_id
+func (c *Client) UpdateProduct(ctx context.Context, id int, product Product, out ResponseModel) error {
+	uri := fmt.Sprintf("/products/%v", id)
+	req, err := c.NewRequest(http.MethodPut, uri, nil, product)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// DeleteProduct marks a product as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/delete_products_id
+func (c *Client) DeleteProduct(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/products/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListProductsOptions is used to configure a list products request.
+type ListProductsOptions struct {
+	UserID    *int    `url:"user_id,omitempty"`    // If supplied, only products owned by the given user will be returned
+	FilterID  *int    `url:"filter_id,omitempty"`  // ID of the filter to use
+	IDs       string  `url:"ids,omitempty"`        // Comma-separated IDs of the products to return
+	FirstChar *string `url:"first_char,omitempty"` // If supplied, only products whose name starts with the specified letter will be returned (case insensitive)
+	Start     *int    `url:"start,omitempty"`      // Pagination start
+	Limit     *int    `url:"limit,omitempty"`      // Items shown per page
+}
+
+// ListProducts lists all products.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products
+func (c *Client) ListProducts(ctx context.Context, opt *ListProductsOptions, out ResponseModel) error {
+	req, err := c.NewRequest(http.MethodGet, "/products", opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// SearchProductsOptions is used to configure a search request. Term is required
+type SearchProductsOptions struct {
+	Term       string              `url:"term"`                  // The search term to look for. Minimum 2 characters (or 1 if using exact_match). (REQUIRED)
+	Fields     *SearchProductField `url:"fields,omitempty"`      // A comma-separated string array. The fields to perform the search from. Defaults to all of them.
+	ExactMatch *bool               `url:"exact_match,omitempty"` // When enabled, only full exact matches against the given term are returned. It is not case sensitive.
+	Start      *int                `url:"start,omitempty"`       // Pagination start.
+	Limit      *int                `url:"limit,omitempty"`       // Items shown per page
+}
+
+// SearchProductsResponse is used to model the search product response
+type SearchProductsResponse struct {
+	Success   bool         `json:"success,omitempty"`
+	Data      ProductItems `json:"data,omitempty"`
+	Error     string       `json:"error,omitempty"`
+	ErrorInfo string       `json:"error_info,omitempty"`
+
+	AdditionalData AdditionalData `json:"additional_data,omitempty"`
+}
+
+// ProductItems contains a list of ProductItem
+type ProductItems struct {
+	Items []ProductItem `json:"items,omitempty"`
+}
+
+// ProductItem contains a SearchResultProduct
+type ProductItem struct {
+	Product     SearchResultProduct `json:"item,omitempty"`
+	ResultScore float64             `json:"result_score,omitempty"`
+}
+
+// SearchResultProduct is the model of a product from the search product response
+type SearchResultProduct struct {
+	ID           int                      `json:"id,omitempty"`
+	Type         string                   `json:"type,omitempty"`
+	Name         string                   `json:"name,omitempty"`
+	Code         string                   `json:"code,omitempty"`
+	VisibleTo    int                      `json:"visible_to,omitempty"`
+	Owner        SearchResultProductOwner `json:"owner,omitempty"`
+	CustomFields []string                 `json:"custom_fields,omitempty"`
+}
+
+// SearchResultProductOwner struct
+type SearchResultProductOwner struct {
+	ID int `json:"id,omitempty"`
+}
+
+// SearchProducts searches all products
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_search
+func (c *Client) SearchProducts(ctx context.Context, opt *SearchProductsOptions) (*SearchProductsResponse, error) {
+	req, err := c.NewRequest(http.MethodGet, "/products/search", opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &SearchProductsResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return nil, err
+	}
+	if !out.Success {
+		return nil, &UnsuccessfulError{Message: out.Error, ErrorInfo: out.ErrorInfo}
+	}
+
+	return out, nil
+}
+
+// ProductVariationPrice is the price of a product variation in one currency.
+type ProductVariationPrice struct {
+	Currency string   `json:"currency"`        // 3-letter currency code
+	Price    float64  `json:"price"`           // Unit price
+	Cost     *float64 `json:"cost,omitempty"`  // Unit cost
+	Notes    string   `json:"notes,omitempty"` // Notes about the price
+}
+
+// ProductVariation is a variant of a product, such as a size or a color,
+// with its own prices. Variations are only available in the v2 API.
+type ProductVariation struct {
+	// Unsettable Fields
+	ID        int `json:"id,omitempty"`
+	ProductID int `json:"product_id,omitempty"`
+
+	// Settable Fields
+	Name   *string                 `json:"name,omitempty"`   // Required when creating
+	Prices []ProductVariationPrice `json:"prices,omitempty"` // Prices of the variation, one per currency
+}
+
+// ListProductVariationsOptions is used to configure a list product variations request.
+type ListProductVariationsOptions struct {
+	Limit  *int    `url:"limit,omitempty"`  // Items shown per page
+	Cursor *string `url:"cursor,omitempty"` // Set by the iterator
+}
+
+// IterateProductVariations returns an Iterator over the variations of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v2/#!/Products/getProductVariations
+func (c *Client) IterateProductVariations(productID int, opt *ListProductVariationsOptions) *Iterator[ProductVariation] {
+	o := copyOptions(opt)
+	return cursorListIterator[ProductVariation](c, fmt.Sprintf("/products/%v/variations", productID), &o.Cursor, o)
+}
+
+// CreateProductVariation adds a variation to a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v2/#!/Products/addProductVariation
+func (c *Client) CreateProductVariation(ctx context.Context, productID int, variation *ProductVariation) (*ProductVariation, error) {
+	uri := fmt.Sprintf("/products/%v/variations", productID)
+	created, _, err := doDataV2[*ProductVariation](ctx, c, http.MethodPost, uri, nil, variation)
+	return created, err
+}
+
+// UpdateProductVariation updates a variation of a product. Only the fields
+// set on variation are changed.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v2/#!/Products/updateProductVariation
+func (c *Client) UpdateProductVariation(ctx context.Context, productID, variationID int, variation *ProductVariation) (*ProductVariation, error) {
+	uri := fmt.Sprintf("/products/%v/variations/%v", productID, variationID)
+	updated, _, err := doDataV2[*ProductVariation](ctx, c, http.MethodPatch, uri, nil, variation)
+	return updated, err
+}
+
+// DeleteProductVariation deletes a variation of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v2/#!/Products/deleteProductVariation
+func (c *Client) DeleteProductVariation(ctx context.Context, productID, variationID int) error {
+	uri := fmt.Sprintf("/products/%v/variations/%v", productID, variationID)
+	req, err := c.NewVersionedRequest(APIVersion2, http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(ctx, c, req, &BaseResponse{})
+	return err
+}
+
+// GetProduct gets a product by ID, decoded into T.
+func GetProduct[T Product](ctx context.Context, c *Client, id int) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodGet, fmt.Sprintf("/products/%v", id), nil, nil)
+}
+
+// CreateProduct creates a product and returns it decoded into T.
+func CreateProduct[T Product](ctx context.Context, c *Client, product Product) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPost, "/products", nil, product)
+}
+
+// UpdateProduct updates a product and returns it decoded into T.
+func UpdateProduct[T Product](ctx context.Context, c *Client, id int, product Product) (T, *Response, error) {
+	return doData[T](ctx, c, http.MethodPut, fmt.Sprintf("/products/%v", id), nil, product)
+}
+
+// ListProducts lists products, decoded into T.
+func ListProducts[T Product](ctx context.Context, c *Client, opt *ListProductsOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, "/products", opt)
+}
+
+// IterateProducts returns an Iterator over all products, decoded into T.
+func IterateProducts[T Product](c *Client, opt *ListProductsOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, "/products", &o.Start, o)
+}
+
+// IterateSearchProducts returns an Iterator over the results of a product search.
+func (c *Client) IterateSearchProducts(opt *SearchProductsOptions) *Iterator[ProductItem] {
+	o := copyOptions(opt)
+	return searchIterator[ProductItem](c, "/products/search", o.Term, &o.Start, o)
+}
@@ -0,0 +1,167 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProducts(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/products":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Widget","code":"W-1","tax":10,"prices":[{"currency":"AUD","price":100,"cost":40},{"currency":"USD","price":70,"cost":0}]}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":1,"name":"Widget","code":"W-1","unit":null,"tax":10,"active_flag":true,"selectable":true,"first_char":"w","visible_to":"3","owner_id":{"id":11535881,"name":"Tom Shi","value":11535881},"files_count":null,"followers_count":0,"add_time":"2020-06-01 02:41:35","update_time":"2020-06-01 02:41:35","prices":[{"id":1,"product_id":1,"price":100,"currency":"AUD","cost":40,"overhead_cost":null},{"id":2,"product_id":1,"price":70,"currency":"USD","cost":0,"overhead_cost":null}]}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/products/1":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"selectable":false}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":1,"name":"Widget","selectable":false}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/products/1":
+			w.Write([]byte(`{"success":true,"data":{"id":1}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/products":
+			assert.Equal(t, "W", req.URL.Query().Get("first_char"))
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"name":"Widget"},{"id":2,"name":"Wrench"}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/products/search":
+			assert.Equal(t, "W-1", req.URL.Query().Get("term"))
+			assert.Equal(t, "code", req.URL.Query().Get("fields"))
+			w.Write([]byte(`{"success":true,"data":{"items":[{"result_score":1,"item":{"id":1,"type":"product","name":"Widget","code":"W-1","visible_to":3,"owner":{"id":11535881},"custom_fields":[]}}]},"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v2/products/1/variations":
+			if req.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"success":true,"data":[{"id":1,"name":"Red","product_id":1,"prices":[{"currency":"AUD","price":110,"cost":45,"notes":""}]}],"additional_data":{"next_cursor":"eyJpZCI6MX0"}}`))
+				return
+			}
+			assert.Equal(t, "eyJpZCI6MX0", req.URL.Query().Get("cursor"))
+			w.Write([]byte(`{"success":true,"data":[{"id":2,"name":"Blue","product_id":1,"prices":[]}],"additional_data":{"next_cursor":null}}`))
+
+		case req.Method == http.MethodPost && req.URL.Path == "/api/v2/products/1/variations":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Green","prices":[{"currency":"AUD","price":120}]}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":3,"name":"Green","product_id":1,"prices":[{"currency":"AUD","price":120,"cost":0,"notes":null}]}}`))
+
+		case req.Method == http.MethodPatch && req.URL.Path == "/api/v2/products/1/variations/3":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Lime"}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":3,"name":"Lime","product_id":1}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/api/v2/products/1/variations/3":
+			w.Write([]byte(`{"success":true,"data":{"id":3}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test create product", func(t *testing.T) {
+		name := "Widget"
+		code := "W-1"
+		tax := 10.0
+		cost, noCost := 40.0, 0.0
+		product := &BaseProductObject{Name: &name, Code: &code, Tax: &tax}
+		product.SetPrice(ProductPrice{Currency: "AUD", Price: 90, Cost: &cost})
+		product.SetPrice(ProductPrice{Currency: "USD", Price: 70, Cost: &noCost})
+		product.SetPrice(ProductPrice{Currency: "AUD", Price: 100, Cost: &cost})
+
+		created, _, err := CreateProduct[BaseProductObject](context.Background(), testClient, product)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 1, created.ID)
+		assert.Equal(t, 11535881, created.OwnerID.ID)
+		assert.Equal(t, VisibleToEntireCompany, *created.VisibleTo)
+		price, ok := created.Price("AUD")
+		assert.True(t, ok)
+		assert.Equal(t, ProductPrice{ID: 1, ProductID: 1, Currency: "AUD", Price: 100, Cost: &cost}, price)
+		price, _ = created.Price("USD")
+		assert.Equal(t, 0.0, *price.Cost)
+		assert.Nil(t, price.OverheadCost)
+		_, ok = created.Price("EUR")
+		assert.False(t, ok)
+	})
+
+	t.Run("Test update product", func(t *testing.T) {
+		selectable := false
+		outProduct := &BaseProductObject{}
+		err := testClient.UpdateProduct(context.Background(), 1, &BaseProductObject{Selectable: &selectable}, &BaseResponse{Data: outProduct})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.False(t, *outProduct.Selectable)
+	})
+
+	t.Run("Test delete product", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteProduct(context.Background(), 1))
+	})
+
+	t.Run("Test iterate products", func(t *testing.T) {
+		firstChar := "W"
+		products, err := IterateProducts[BaseProductObject](testClient, &ListProductsOptions{FirstChar: &firstChar}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Len(t, products, 2)
+	})
+
+	t.Run("Test search products", func(t *testing.T) {
+		fields := SearchProductCode
+		items, err := testClient.IterateSearchProducts(&SearchProductsOptions{Term: "W-1", Fields: &fields}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, items, 1) {
+			assert.Equal(t, "W-1", items[0].Product.Code)
+			assert.Equal(t, 11535881, items[0].Product.Owner.ID)
+		}
+	})
+
+	t.Run("Test product variations", func(t *testing.T) {
+		variations, err := testClient.IterateProductVariations(1, nil).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, variations, 2) {
+			assert.Equal(t, "Red", *variations[0].Name)
+			assert.Equal(t, 110.0, variations[0].Prices[0].Price)
+			assert.Equal(t, "Blue", *variations[1].Name)
+		}
+
+		name := "Green"
+		created, err := testClient.CreateProductVariation(context.Background(), 1, &ProductVariation{
+			Name:   &name,
+			Prices: []ProductVariationPrice{{Currency: "AUD", Price: 120}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 3, created.ID)
+
+		name = "Lime"
+		updated, err := testClient.UpdateProductVariation(context.Background(), 1, 3, &ProductVariation{Name: &name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "Lime", *updated.Name)
+
+		assert.Nil(t, testClient.DeleteProductVariation(context.Background(), 1, 3))
+	})
+}
@@ -0,0 +1,162 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimitMode controls what the client does when the rate limit window
+// of the API token is exhausted.
+type RateLimitMode uint8
+
+const (
+	// RateLimitFailFast returns a RateLimitError without calling the API.
+	RateLimitFailFast RateLimitMode = iota
+
+	// RateLimitWait blocks until the rate limit window resets, or until the
+	// context of the call is done.
+	RateLimitWait
+)
+
+// rateLimiter is a token bucket driven by the X-RateLimit-* response headers.
+// Tokens are taken locally before each request, so concurrent callers don't
+// all rush through on a stale Remaining value, and refilled when the window
+// reported by the API resets.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate   Rate
+	window time.Duration
+}
+
+// current returns the last known rate.
+func (l *rateLimiter) current() Rate {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// exhausted reports whether no tokens are left in the current window.
+func (l *rateLimiter) exhausted() (Rate, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+
+	return l.rate, l.limited() && l.rate.Remaining <= 0
+}
+
+// wait takes a token, blocking until the window resets if none are left.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.refill(now)
+
+		if !l.limited() || l.rate.Remaining > 0 {
+			// Unknown limits let the request through; the response headers
+			// will tell us where we stand.
+			if l.rate.Remaining > 0 {
+				l.rate.Remaining--
+			}
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		delay := l.rate.Reset.Sub(now)
+		l.mu.Unlock()
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// limited reports whether the rate limit is known. Without a Limit no window
+// would ever refill the bucket, so it is treated as unlimited.
+// Must be called with l.mu held.
+func (l *rateLimiter) limited() bool {
+	return !l.rate.Reset.IsZero() && l.rate.Limit > 0
+}
+
+// refill starts a new window once the current one has reset.
+// Must be called with l.mu held.
+func (l *rateLimiter) refill(now time.Time) {
+	if l.rate.Reset.IsZero() || now.Before(l.rate.Reset.Time) {
+		return
+	}
+
+	l.rate.Remaining = l.rate.Limit
+
+	if l.window > 0 {
+		l.rate.Reset = Timestamp{now.Add(l.window)}
+	} else {
+		l.rate.Reset = Timestamp{}
+	}
+}
+
+// update merges the rate reported by a response. Responses of concurrent
+// requests arrive out of order, so a response from an older window is
+// ignored and, within the same window, the lowest Remaining wins.
+func (l *rateLimiter) update(rate Rate) {
+	if rate.Reset.IsZero() {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	if window := rate.Reset.Sub(now); window > l.window {
+		l.window = window
+	}
+
+	// Reset is only reported with a precision of one second.
+	switch current := l.rate.Reset.Time; {
+	case current.IsZero() || rate.Reset.After(current.Add(time.Second)):
+		l.rate = rate
+
+	case rate.Reset.Before(current.Add(-time.Second)):
+		// Stale response from a previous window.
+
+	default:
+		if rate.Remaining < l.rate.Remaining {
+			l.rate.Remaining = rate.Remaining
+		}
+		if rate.Limit > 0 {
+			l.rate.Limit = rate.Limit
+		}
+	}
+}
+
+// Rate returns the rate limit status last reported by the API.
+func (c *Client) Rate() Rate {
+	return c.rate.current()
+}
+
+// send performs a single round trip, keeping the rate limiter in sync.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.rateLimitMode == RateLimitWait {
+		if err := c.rate.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.rate.update(parseRateFromResponse(resp))
+
+	if c.metrics != nil {
+		c.metrics.ObserveRate(c.rate.current())
+	}
+
+	return resp, nil
+}
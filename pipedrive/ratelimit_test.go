@@ -0,0 +1,197 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("Test fail fast when exhausted", func(t *testing.T) {
+		calls := 0
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			calls++
+			w.Header().Set("X-RateLimit-Limit", "2")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "2")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+
+		err := testClient.DeleteDeal(context.Background(), 1)
+		if assert.IsType(t, &RateLimitError{}, err) {
+			assert.Equal(t, 2, err.(*RateLimitError).Rate.Limit)
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Test fail fast clears after reset", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "2")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+	})
+
+	t.Run("Test wait mode blocks until reset", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "1")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "1")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:        "1",
+			BaseURL:       testAPI.URL,
+			RateLimitMode: RateLimitWait,
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+
+		start := time.Now()
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+		assert.True(t, time.Since(start) >= 500*time.Millisecond)
+	})
+
+	t.Run("Test wait mode is bounded by context", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "1")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "60")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:        "1",
+			BaseURL:       testAPI.URL,
+			RateLimitMode: RateLimitWait,
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, context.DeadlineExceeded, testClient.DeleteDeal(ctx, 1))
+	})
+
+	t.Run("Test wait mode without limit", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "0")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "60")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:        "1",
+			BaseURL:       testAPI.URL,
+			RateLimitMode: RateLimitWait,
+		}
+		testClient := NewClient(config)
+
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.Nil(t, testClient.DeleteDeal(ctx, 1))
+	})
+
+	t.Run("Test concurrent callers share the bucket", func(t *testing.T) {
+		var inWindow int32
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			remaining := 5 - atomic.AddInt32(&inWindow, 1)
+			if remaining < 0 {
+				remaining = 0
+			}
+			w.Header().Set("X-RateLimit-Limit", "5")
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(int(remaining)))
+			w.Header().Set("X-RateLimit-Reset", "60")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:        "1",
+			BaseURL:       testAPI.URL,
+			RateLimitMode: RateLimitWait,
+		}
+		testClient := NewClient(config)
+
+		// Prime the bucket with the headers of the first response.
+		assert.Nil(t, testClient.DeleteDeal(context.Background(), 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		var succeeded int32
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if testClient.DeleteDeal(ctx, 1) == nil {
+					atomic.AddInt32(&succeeded, 1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(4), succeeded)
+		assert.Equal(t, 0, testClient.Rate().Remaining)
+	})
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	now := time.Now()
+	l := &rateLimiter{}
+
+	l.update(Rate{Limit: 10, Remaining: 5, Reset: Timestamp{now.Add(2 * time.Second)}})
+	l.update(Rate{Limit: 10, Remaining: 7, Reset: Timestamp{now.Add(2 * time.Second)}})
+	assert.Equal(t, 5, l.current().Remaining)
+
+	l.update(Rate{Limit: 10, Remaining: 9, Reset: Timestamp{now.Add(-5 * time.Second)}})
+	assert.Equal(t, 5, l.current().Remaining)
+
+	l.update(Rate{Limit: 10, Remaining: 9, Reset: Timestamp{now.Add(10 * time.Second)}})
+	assert.Equal(t, 9, l.current().Remaining)
+}
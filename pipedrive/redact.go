@@ -0,0 +1,84 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// redacted replaces credentials in any URL or header the library surfaces.
+const redacted = "REDACTED"
+
+// Query parameters that carry credentials.
+var credentialParams = []string{"api_token", "access_token", "refresh_token", "client_secret"}
+
+// authorize sets the credentials on the request. API tokens are sent in the
+// x-api-token header so they never end up in URLs, logs or error messages.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	if c.tokenSource != nil {
+		return c.authorizeOAuth(ctx, req)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set(headerAPIToken, c.apiKey)
+	}
+
+	return nil
+}
+
+// redactURL returns rawURL with the values of credential query parameters redacted.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	return redactedURL(u).String()
+}
+
+// redactedURL returns a copy of u with the values of credential query
+// parameters redacted.
+func redactedURL(u *url.URL) *url.URL {
+	if u == nil {
+		return nil
+	}
+
+	clean := *u
+
+	if clean.User != nil {
+		clean.User = url.User(redacted)
+	}
+
+	if clean.RawQuery == "" {
+		return &clean
+	}
+
+	q := clean.Query()
+	changed := false
+
+	for _, param := range credentialParams {
+		if _, ok := q[param]; ok {
+			q.Set(param, redacted)
+			changed = true
+		}
+	}
+
+	if changed {
+		clean.RawQuery = q.Encode()
+	}
+
+	return &clean
+}
+
+// redactedHeader returns a copy of h with credential headers redacted.
+func redactedHeader(h http.Header) http.Header {
+	clean := h.Clone()
+
+	for _, key := range []string{headerAPIToken, "Authorization", "Cookie", "Set-Cookie"} {
+		if clean.Get(key) != "" {
+			clean.Set(key, redacted)
+		}
+	}
+
+	return clean
+}
@@ -0,0 +1,61 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokenHeader(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "secret-token", req.Header.Get("x-api-token"))
+		assert.Empty(t, req.URL.Query().Get("api_token"))
+		assert.Equal(t, "term=test", req.URL.RawQuery)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"success":true,"data":{"items":[]}}`))
+	}))
+	defer testAPI.Close()
+
+	config := &Config{
+		APIKey:  "secret-token",
+		BaseURL: testAPI.URL,
+	}
+	testClient := NewClient(config)
+
+	_, err := testClient.SearchPersons(context.Background(), &SearchPersonsOptions{Term: "test"})
+	assert.Nil(t, err)
+}
+
+func TestRedactURL(t *testing.T) {
+	assert.Equal(t, "https://api.pipedrive.com/v1/deals?api_token=REDACTED&start=0",
+		redactURL("https://api.pipedrive.com/v1/deals?api_token=secret-token&start=0"))
+	assert.Equal(t, "https://api.pipedrive.com/v1/deals?start=0",
+		redactURL("https://api.pipedrive.com/v1/deals?start=0"))
+
+	t.Run("Test rate limit error", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.pipedrive.com/v1/deals?api_token=secret-token", nil)
+		err := &RateLimitError{
+			Response: &http.Response{Request: req, StatusCode: 429},
+			Message:  "API rate limit of 80 exceeded.",
+		}
+
+		assert.NotContains(t, err.Error(), "secret-token")
+	})
+
+	t.Run("Test transport error", func(t *testing.T) {
+		testClient := NewClient(&Config{
+			APIKey:  "secret-token",
+			BaseURL: "http://127.0.0.1:1",
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1:1/v1/deals?api_token=secret-token", nil)
+		_, err := testClient.Do(context.Background(), req, &BaseResponse{})
+		if assert.NotNil(t, err) {
+			assert.NotContains(t, err.Error(), "secret-token")
+		}
+	})
+}
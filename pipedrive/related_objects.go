@@ -0,0 +1,147 @@
+package pipedrive
+
+// PersonRef is a person as sent in related_objects.
+type PersonRef struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Email      []*Email `json:"email,omitempty"`
+	Phone      []*Phone `json:"phone,omitempty"`
+	ActiveFlag bool     `json:"active_flag"`
+}
+
+// OrganizationRef is an organization as sent in related_objects.
+type OrganizationRef struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PeopleCount int    `json:"people_count,omitempty"`
+	OwnerID     int    `json:"owner_id,omitempty"`
+	Address     string `json:"address,omitempty"`
+	CCEmail     string `json:"cc_email,omitempty"`
+	ActiveFlag  bool   `json:"active_flag"`
+}
+
+// DealRef is a deal as sent in related_objects.
+type DealRef struct {
+	ID         int        `json:"id"`
+	Title      string     `json:"title"`
+	Status     DealStatus `json:"status,omitempty"`
+	Value      float64    `json:"value"`
+	Currency   string     `json:"currency,omitempty"`
+	StageID    int        `json:"stage_id,omitempty"`
+	PipelineID int        `json:"pipeline_id,omitempty"`
+}
+
+// StageRef is a stage as sent in related_objects.
+type StageRef struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	OrderNr    int    `json:"order_nr,omitempty"`
+	PipelineID int    `json:"pipeline_id,omitempty"`
+	ActiveFlag bool   `json:"active_flag"`
+}
+
+// PipelineRef is a pipeline as sent in related_objects.
+type PipelineRef struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	URLTitle string `json:"url_title,omitempty"`
+	Active   bool   `json:"active"`
+}
+
+// RelatedObjects holds the sidecar objects Pipedrive sends along with
+// entities, keyed by ID. They allow rendering e.g. a list of deals with
+// owner and person names without extra API calls.
+//
+// Lookups are safe on a nil *RelatedObjects.
+type RelatedObjects struct {
+	Users         map[int]User            `json:"user,omitempty"`
+	Persons       map[int]PersonRef       `json:"person,omitempty"`
+	Organizations map[int]OrganizationRef `json:"organization,omitempty"`
+	Deals         map[int]DealRef         `json:"deal,omitempty"`
+	Stages        map[int]StageRef        `json:"stage,omitempty"`
+	Pipelines     map[int]PipelineRef     `json:"pipeline,omitempty"`
+}
+
+// User returns the related user with the given ID.
+func (r *RelatedObjects) User(id int) (User, bool) {
+	if r == nil {
+		return User{}, false
+	}
+
+	u, ok := r.Users[id]
+	return u, ok
+}
+
+// Person returns the related person with the given ID.
+func (r *RelatedObjects) Person(id int) (PersonRef, bool) {
+	if r == nil {
+		return PersonRef{}, false
+	}
+
+	p, ok := r.Persons[id]
+	return p, ok
+}
+
+// Organization returns the related organization with the given ID.
+func (r *RelatedObjects) Organization(id int) (OrganizationRef, bool) {
+	if r == nil {
+		return OrganizationRef{}, false
+	}
+
+	o, ok := r.Organizations[id]
+	return o, ok
+}
+
+// Deal returns the related deal with the given ID.
+func (r *RelatedObjects) Deal(id int) (DealRef, bool) {
+	if r == nil {
+		return DealRef{}, false
+	}
+
+	d, ok := r.Deals[id]
+	return d, ok
+}
+
+// Stage returns the related stage with the given ID.
+func (r *RelatedObjects) Stage(id int) (StageRef, bool) {
+	if r == nil {
+		return StageRef{}, false
+	}
+
+	s, ok := r.Stages[id]
+	return s, ok
+}
+
+// Pipeline returns the related pipeline with the given ID.
+func (r *RelatedObjects) Pipeline(id int) (PipelineRef, bool) {
+	if r == nil {
+		return PipelineRef{}, false
+	}
+
+	p, ok := r.Pipelines[id]
+	return p, ok
+}
+
+// UserName returns the name of the related user, or "" if it's unknown.
+func (r *RelatedObjects) UserName(id int) string {
+	u, _ := r.User(id)
+	return u.Name
+}
+
+// PersonName returns the name of the related person, or "" if it's unknown.
+func (r *RelatedObjects) PersonName(id int) string {
+	p, _ := r.Person(id)
+	return p.Name
+}
+
+// OrganizationName returns the name of the related organization, or "" if it's unknown.
+func (r *RelatedObjects) OrganizationName(id int) string {
+	o, _ := r.Organization(id)
+	return o.Name
+}
+
+// StageName returns the name of the related stage, or "" if it's unknown.
+func (r *RelatedObjects) StageName(id int) string {
+	s, _ := r.Stage(id)
+	return s.Name
+}
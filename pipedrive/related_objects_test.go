@@ -0,0 +1,78 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelatedObjects(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/v1/deals/5":
+			w.Write([]byte(`{"success":true,"data":{"id":5,"title":"test 456"},"related_objects":{"user":{"11535881":{"id":11535881,"name":"Tom Shi","email":"tom.shi@societyone.com.au","has_pic":0,"pic_hash":null,"active_flag":true}},"person":{"3":{"active_flag":true,"id":3,"name":"testtest","email":[{"value":"","primary":true}],"phone":[{"value":"","primary":true}]}}}}`))
+		case "/v1/persons/3/deals":
+			w.Write([]byte(`{"success":true,"data":[{"id":1,"title":"one","user_id":11535881,"person_id":3,"stage_id":80},{"id":2,"title":"two","user_id":1,"person_id":3,"stage_id":80}],"related_objects":{"user":{"11535881":{"id":11535881,"name":"Tom Shi"}},"person":{"3":{"id":3,"name":"testtest"}},"stage":{"80":{"id":80,"name":"Qualified","pipeline_id":10,"order_nr":1}}}}`))
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test base response", func(t *testing.T) {
+		out := &BaseResponse{Data: &BaseDealObject{}}
+		err := testClient.GetDeal(context.Background(), 5, out)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user, ok := out.RelatedObjects.User(11535881)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Tom Shi", user.Name)
+			assert.True(t, user.ActiveFlag)
+		}
+
+		person, ok := out.RelatedObjects.Person(3)
+		if assert.True(t, ok) {
+			assert.Equal(t, "testtest", person.Name)
+			assert.Len(t, person.Email, 1)
+		}
+	})
+
+	t.Run("Test rendering a list of deals", func(t *testing.T) {
+		type listedDeal struct {
+			ID       int    `json:"id"`
+			Title    string `json:"title"`
+			UserID   int    `json:"user_id"`
+			PersonID int    `json:"person_id"`
+			StageID  int    `json:"stage_id"`
+		}
+
+		list, _, err := ListDeals[listedDeal](context.Background(), testClient, 3, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		related, err := list.Related()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "Tom Shi", related.UserName(list.Data[0].UserID))
+		assert.Equal(t, "", related.UserName(list.Data[1].UserID))
+		assert.Equal(t, "testtest", related.PersonName(list.Data[1].PersonID))
+		assert.Equal(t, "Qualified", related.StageName(list.Data[0].StageID))
+	})
+
+	t.Run("Test nil related objects", func(t *testing.T) {
+		var related *RelatedObjects
+
+		_, ok := related.Organization(1)
+		assert.False(t, ok)
+		assert.Equal(t, "", related.PersonName(1))
+	})
+}
@@ -0,0 +1,201 @@
+package pipedrive
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+
+	headerRetryAfter = "Retry-After"
+)
+
+// RetryPolicy configures how the client retries requests that failed with
+// a transient error: network errors, 429 Too Many Requests, rate limited
+// 403 responses and 5xx server errors.
+//
+// Only idempotent methods (GET, PUT, DELETE) are retried unless RetryPost
+// is set. Retry-After and X-RateLimit-Reset headers take precedence over
+// the computed backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+
+	// MinBackoff is the base delay of the exponential backoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff delay.
+	MaxBackoff time.Duration
+
+	// RetryPost opts POST requests in to retries. Enable it only if creating
+	// the same object twice is acceptable.
+	RetryPost bool
+}
+
+// NewRetryPolicy returns a retry policy with sensible defaults.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// retryable reports whether the request method may be retried.
+func (p *RetryPolicy) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body can't be rewound, so a retry would send an empty payload.
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return p.RetryPost
+	}
+
+	return false
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusTooManyRequests:
+		return true
+	case code == http.StatusForbidden && resp.Header.Get(headerRateRemaining) == "0":
+		return true
+	case code == http.StatusNotImplemented:
+		return false
+	case code >= 500:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the given retry attempt (starting at 1).
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header); ok {
+			return d
+		}
+	}
+
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	d := p.MinBackoff
+	if d <= 0 {
+		d = defaultRetryMinBackoff
+	}
+
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+
+	if d > max {
+		d = max
+	}
+
+	// Full jitter spreads out retries of concurrent callers.
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// retryAfter reads the server supplied delay from Retry-After or X-RateLimit-Reset.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	if v := h.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+
+		if t, err := http.ParseTime(v); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d, true
+			}
+
+			return 0, true
+		}
+	}
+
+	if v := h.Get(headerRateReset); v != "" && h.Get(headerRateRemaining) == "0" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	return 0, false
+}
+
+// doWithRetry sends the request, retrying transient failures according to
+// the client's retry policy.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy
+
+	if policy == nil || policy.MaxAttempts <= 1 || !policy.retryable(req) {
+		return c.send(ctx, req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req)
+
+		if attempt >= policy.MaxAttempts || !policy.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt, resp)
+
+		if c.metrics != nil {
+			c.metrics.ObserveRetry(req.Method, c.endpointTemplate(req.URL))
+		}
+
+		if resp != nil {
+			io.CopyN(ioutil.Discard, resp.Body, 512)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req.Body = body
+		}
+	}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
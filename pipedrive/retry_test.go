@@ -0,0 +1,151 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("Test retry GET on 503", func(t *testing.T) {
+		attempts := 0
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(503)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true,"data":{"id":1,"content":"note"}}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 3,
+				MinBackoff:  time.Millisecond,
+				MaxBackoff:  5 * time.Millisecond,
+			},
+		}
+		testClient := NewClient(config)
+
+		outNote := &BaseNoteObject{}
+		err := testClient.GetNote(context.Background(), 1, &BaseResponse{Data: outNote})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, 1, outNote.ID)
+	})
+
+	t.Run("Test honor Retry-After on 429", func(t *testing.T) {
+		attempts := 0
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(429)
+				return
+			}
+			w.WriteHeader(200)
+			w.Write([]byte(`{"success":true}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 2,
+				MinBackoff:  time.Hour,
+				MaxBackoff:  time.Hour,
+			},
+		}
+		testClient := NewClient(config)
+
+		err := testClient.DeleteDeal(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Test POST is not retried by default", func(t *testing.T) {
+		attempts := 0
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			attempts++
+			w.WriteHeader(502)
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:      "1",
+			BaseURL:     testAPI.URL,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+		}
+		testClient := NewClient(config)
+
+		err := testClient.CreateNote(context.Background(), &BaseNoteObject{}, &BaseResponse{})
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Test retried POST resends the body", func(t *testing.T) {
+		var bodies []string
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			b, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				w.WriteHeader(500)
+				return
+			}
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":1}}`))
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:  "1",
+			BaseURL: testAPI.URL,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 2,
+				MinBackoff:  time.Millisecond,
+				RetryPost:   true,
+			},
+		}
+		testClient := NewClient(config)
+
+		content := "hello"
+		err := testClient.CreateNote(context.Background(), &BaseNoteObject{Content: &content}, &BaseResponse{})
+		assert.Nil(t, err)
+		if assert.Len(t, bodies, 2) {
+			assert.Equal(t, bodies[0], bodies[1])
+			assert.Contains(t, bodies[1], `"content":"hello"`)
+		}
+	})
+
+	t.Run("Test backoff is bounded by context", func(t *testing.T) {
+		testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(429)
+		}))
+		defer testAPI.Close()
+
+		config := &Config{
+			APIKey:      "1",
+			BaseURL:     testAPI.URL,
+			RetryPolicy: NewRetryPolicy(),
+		}
+		testClient := NewClient(config)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := testClient.DeleteDeal(ctx, 1)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
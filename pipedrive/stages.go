@@ -0,0 +1,152 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages
+
+// Stage is a step of a pipeline. The stage of a deal is its StageID.
+// Nil fields are left unchanged by updates; see Nullable.
+type Stage struct {
+	// Unsettable Fields
+	ID                      int       `json:"id,omitempty"`
+	ActiveFlag              bool      `json:"active_flag,omitempty"`
+	PipelineName            string    `json:"pipeline_name,omitempty"`
+	PipelineDealProbability bool      `json:"pipeline_deal_probability,omitempty"`
+	AddTime                 *DateTime `json:"add_time,omitempty"`
+	UpdateTime              *DateTime `json:"update_time,omitempty"`
+
+	// Settable Fields
+	Name            *string        `json:"name,omitempty"`             // Required when creating
+	PipelineID      *int           `json:"pipeline_id,omitempty"`      // ID of the pipeline to add stage to. Required when creating
+	OrderNr         *int           `json:"order_nr,omitempty"`         // An order number for this stage. Order numbers should be used to order the stages in the pipeline
+	DealProbability *int           `json:"deal_probability,omitempty"` // Deal success probability percentage
+	RottenFlag      *bool          `json:"rotten_flag,omitempty"`      // Whether deals in this stage can become rotten
+	RottenDays      *Nullable[int] `json:"rotten_days,omitempty"`      // The number of days the deals not updated in this stage would become rotten. Applies only if RottenFlag is set
+}
+
+// ListStagesOptions is used to configure a list stages request.
+type ListStagesOptions struct {
+	PipelineID *int `url:"pipeline_id,omitempty"` // The ID of the pipeline to fetch stages for. If omitted, stages for all pipelines will be fetched
+}
+
+// ListStages lists stages, ordered by pipeline and order number.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages
+func (c *Client) ListStages(ctx context.Context, opt *ListStagesOptions) ([]Stage, error) {
+	stages, _, err := doData[[]Stage](ctx, c, http.MethodGet, "/stages", opt, nil)
+	return stages, err
+}
+
+// GetStage gets a stage by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id
+func (c *Client) GetStage(ctx context.Context, id int) (*Stage, error) {
+	stage, _, err := doData[*Stage](ctx, c, http.MethodGet, fmt.Sprintf("/stages/%v", id), nil, nil)
+	return stage, err
+}
+
+// CreateStage creates a stage. Name and PipelineID are required.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/post_stages
+func (c *Client) CreateStage(ctx context.Context, stage *Stage) (*Stage, error) {
+	created, _, err := doData[*Stage](ctx, c, http.MethodPost, "/stages", nil, stage)
+	return created, err
+}
+
+// UpdateStage updates a stage.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/put_stages_id
+func (c *Client) UpdateStage(ctx context.Context, id int, stage *Stage) (*Stage, error) {
+	updated, _, err := doData[*Stage](ctx, c, http.MethodPut, fmt.Sprintf("/stages/%v", id), nil, stage)
+	return updated, err
+}
+
+// DeleteStage marks a stage as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/delete_stages_id
+func (c *Client) DeleteStage(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("/stages/%v", id)
+	req, err := c.NewRequest(http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// DeleteStages marks multiple stages as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/delete_stages
+func (c *Client) DeleteStages(ctx context.Context, ids []int) error {
+	req, err := c.NewRequest(http.MethodDelete, "/stages", &DeleteMultipleOptions{
+		Ids: arrayToString(ids, ","),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	out := &BaseResponse{}
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListStageDealsOptions is used to configure a list stage deals request.
+type ListStageDealsOptions struct {
+	FilterID *int  `url:"filter_id,omitempty"`    // If supplied, only deals matching the given filter will be returned
+	UserID   *int  `url:"user_id,omitempty"`      // If supplied, filter_id will not be considered and only deals owned by the given user will be returned. If omitted, deals owned by the authorized user will be returned
+	Everyone *bool `url:"everyone,omitempty,int"` // If supplied, filter_id and user_id will not be considered – instead, deals owned by everyone will be returned
+	Start    *int  `url:"start,omitempty"`        // Pagination start
+	Limit    *int  `url:"limit,omitempty"`        // Items shown per page
+}
+
+// ListStageDeals lists deals in a stage.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id_deals
+func (c *Client) ListStageDeals(ctx context.Context, id int, opt *ListStageDealsOptions, out ResponseModel) error {
+	uri := fmt.Sprintf("/stages/%v/deals", id)
+	req, err := c.NewRequest(http.MethodGet, uri, opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, out)
+	if err != nil {
+		return err
+	}
+	if !out.Successful() {
+		return unsuccessful(out)
+	}
+
+	return nil
+}
+
+// ListStageDeals lists deals in a stage, decoded into T.
+func ListStageDeals[T Deal](ctx context.Context, c *Client, id int, opt *ListStageDealsOptions) (*ListResponse[T], *Response, error) {
+	return doList[T](ctx, c, http.MethodGet, fmt.Sprintf("/stages/%v/deals", id), opt)
+}
+
+// IterateStageDeals returns an Iterator over the deals in a stage, decoded into T.
+func IterateStageDeals[T Deal](c *Client, id int, opt *ListStageDealsOptions) *Iterator[T] {
+	o := copyOptions(opt)
+	return listIterator[T](c, fmt.Sprintf("/stages/%v/deals", id), &o.Start, o)
+}
@@ -0,0 +1,84 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStages(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/stages":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"name":"Negotiation","pipeline_id":1,"deal_probability":80}`, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"success":true,"data":{"id":3,"order_nr":3,"name":"Negotiation","active_flag":true,"deal_probability":80,"pipeline_id":1,"rotten_flag":false,"rotten_days":null,"add_time":"2020-06-01 02:41:35"}}`))
+
+		case req.Method == http.MethodPut && req.URL.Path == "/v1/stages/3":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"rotten_flag":false,"rotten_days":null}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":3,"rotten_flag":false,"rotten_days":null}}`))
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/stages":
+			assert.Equal(t, "3,4", req.URL.Query().Get("ids"))
+			w.Write([]byte(`{"success":true,"data":{"id":[3,4]}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/stages/2/deals":
+			w.Write([]byte(`{"success":true,"data":[{"id":16143,"title":"Big deal","stage_id":2}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test create stage", func(t *testing.T) {
+		name := "Negotiation"
+		pipelineID := 1
+		probability := 80
+		stage, err := testClient.CreateStage(context.Background(), &Stage{
+			Name:            &name,
+			PipelineID:      &pipelineID,
+			DealProbability: &probability,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 3, stage.ID)
+		assert.Equal(t, 3, *stage.OrderNr)
+		assert.True(t, stage.ActiveFlag)
+		_, ok := stage.RottenDays.Get()
+		assert.False(t, ok)
+	})
+
+	t.Run("Test update stage", func(t *testing.T) {
+		rotten := false
+		_, err := testClient.UpdateStage(context.Background(), 3, &Stage{RottenFlag: &rotten, RottenDays: Null[int]()})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test delete stages", func(t *testing.T) {
+		assert.Nil(t, testClient.DeleteStages(context.Background(), []int{3, 4}))
+	})
+
+	t.Run("Test iterate stage deals", func(t *testing.T) {
+		deals, err := IterateStageDeals[BaseDealObject](testClient, 2, nil).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, deals, 1) {
+			assert.Equal(t, "Big deal", *deals[0].Title)
+		}
+	})
+}
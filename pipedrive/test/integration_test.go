@@ -31,9 +31,9 @@ func TestIntegration(t *testing.T) {
 	personToCreate := &TestPerson{
 		BasePersonObject: pipedrive.BasePersonObject{
 			Name: &personName,
-			OrgID: &pipedrive.OrgID{
+			OrgID: pipedrive.NewNullable(pipedrive.OrgID{
 				ID: 1,
-			},
+			}),
 		},
 		FirstChar: &randomChar,
 	}
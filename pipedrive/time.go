@@ -1,7 +1,21 @@
 package pipedrive
 
 // http://fuckinggodateformat.com/
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateTimeLayout  = "2006-01-02 15:04:05"
+	dateLayout      = "2006-01-02"
+	timeOfDayLayout = "15:04"
+)
 
 type Timestamp struct {
 	time.Time
@@ -18,3 +32,242 @@ func (t Timestamp) Format() string {
 func (t Timestamp) FormatFull() string {
 	return t.Time.Format("2006-01-02 15:04:05")
 }
+
+// DateTime is a point in time as used by fields like add_time and
+// update_time: "2006-01-02 15:04:05" in UTC. null and "" decode to the zero
+// value, which encodes as null.
+type DateTime struct {
+	time.Time
+}
+
+// NewDateTime returns a DateTime for t.
+func NewDateTime(t time.Time) *DateTime {
+	return &DateTime{t}
+}
+
+func (t DateTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. RFC 3339 timestamps, as sent by
+// webhooks and v2 endpoints, are accepted too.
+func (t *DateTime) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalTimeString(data)
+	if err != nil || s == "" {
+		*t = DateTime{}
+		return err
+	}
+
+	parsed, err := time.ParseInLocation(dateTimeLayout, s, time.UTC)
+	if err != nil {
+		if parsed, err = time.Parse(time.RFC3339, s); err != nil {
+			return fmt.Errorf("pipedrive: invalid date time %q", s)
+		}
+	}
+
+	*t = DateTime{parsed}
+
+	return nil
+}
+
+// EncodeValues implements query.Encoder, so a DateTime can be used in options.
+func (t DateTime) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, t.String())
+	return nil
+}
+
+// Date is a calendar date such as expected_close_date or due_date:
+// "2006-01-02". null and "" decode to the zero value, which encodes as null.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the Date of the given day.
+func NewDate(year int, month time.Month, day int) *Date {
+	return &Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Format(dateLayout)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalTimeString(data)
+	if err != nil || s == "" {
+		*d = Date{}
+		return err
+	}
+
+	parsed, err := time.ParseInLocation(dateLayout, s, time.UTC)
+	if err != nil {
+		return fmt.Errorf("pipedrive: invalid date %q", s)
+	}
+
+	*d = Date{parsed}
+
+	return nil
+}
+
+// EncodeValues implements query.Encoder, so a Date can be used in options.
+func (d Date) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, d.String())
+	return nil
+}
+
+// TimeOfDay is a wall clock time in UTC such as due_time: "15:04".
+// null and "" decode to the zero value, which encodes as null.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+
+	valid bool
+}
+
+// NewTimeOfDay returns the TimeOfDay of the given hour and minute.
+func NewTimeOfDay(hour, minute int) *TimeOfDay {
+	return &TimeOfDay{Hour: hour, Minute: minute, valid: true}
+}
+
+// IsZero reports whether t is unset.
+func (t TimeOfDay) IsZero() bool {
+	return !t.valid && t.Hour == 0 && t.Minute == 0
+}
+
+func (t TimeOfDay) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Seconds, as sent by v2
+// endpoints, are accepted and dropped.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalTimeString(data)
+	if err != nil || s == "" {
+		*t = TimeOfDay{}
+		return err
+	}
+
+	parsed, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		if parsed, err = time.Parse("15:04:05", s); err != nil {
+			return fmt.Errorf("pipedrive: invalid time of day %q", s)
+		}
+	}
+
+	*t = TimeOfDay{Hour: parsed.Hour(), Minute: parsed.Minute(), valid: true}
+
+	return nil
+}
+
+// Duration is a length of time such as an activity duration: "HH:MM".
+// null and "" decode to the zero value, which encodes as null.
+type Duration struct {
+	time.Duration
+
+	valid bool
+}
+
+// NewDuration returns a Duration of d, truncated to the minute.
+func NewDuration(d time.Duration) *Duration {
+	return &Duration{Duration: d.Truncate(time.Minute), valid: true}
+}
+
+// IsZero reports whether d is unset.
+func (d Duration) IsZero() bool {
+	return !d.valid && d.Duration == 0
+}
+
+func (d Duration) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	minutes := int(d.Duration / time.Minute)
+
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalTimeString(data)
+	if err != nil || s == "" {
+		*d = Duration{}
+		return err
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return fmt.Errorf("pipedrive: invalid duration %q", s)
+	}
+
+	var total time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return fmt.Errorf("pipedrive: invalid duration %q", s)
+		}
+
+		total += time.Duration(n) * units[i]
+	}
+
+	*d = Duration{Duration: total, valid: true}
+
+	return nil
+}
+
+// marshalTimeString encodes s as a JSON string, or null if it's empty.
+func marshalTimeString(s string) ([]byte, error) {
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(s)
+}
+
+// unmarshalTimeString decodes a JSON string, treating null as "".
+func unmarshalTimeString(data []byte) (string, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(s), nil
+}
@@ -0,0 +1,93 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeTypes(t *testing.T) {
+	t.Run("Test decoding a deal", func(t *testing.T) {
+		deal := &BaseDealObject{}
+		err := json.Unmarshal([]byte(`{"id":1,"add_time":"2020-06-01 02:41:35","update_time":"","won_time":null,"expected_close_date":"2020-07-15"}`), deal)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, time.Date(2020, 6, 1, 2, 41, 35, 0, time.UTC), deal.AddTime.Time)
+		assert.True(t, deal.UpdateTime.IsZero())
+		assert.Nil(t, deal.WonTime)
+		assert.Equal(t, "2020-07-15", deal.ExpectedCloseDate.Value.String())
+	})
+
+	t.Run("Test round trip of an activity", func(t *testing.T) {
+		activity := &BaseActivityObject{
+			DueDate:  NewDate(2020, time.June, 1),
+			DueTime:  NewTimeOfDay(0, 0),
+			Duration: NewDuration(90 * time.Minute),
+		}
+
+		data, err := json.Marshal(activity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"due_date":"2020-06-01","due_time":"00:00","duration":"01:30"}`, string(data))
+
+		decoded := &BaseActivityObject{}
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, activity, decoded)
+	})
+
+	t.Run("Test zero values", func(t *testing.T) {
+		data, err := json.Marshal(struct {
+			A DateTime  `json:"a"`
+			B Date      `json:"b"`
+			C TimeOfDay `json:"c"`
+			D Duration  `json:"d"`
+		}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"a":null,"b":null,"c":null,"d":null}`, string(data))
+	})
+
+	t.Run("Test zero duration", func(t *testing.T) {
+		var d Duration
+		assert.Nil(t, json.Unmarshal([]byte(`"00:00"`), &d))
+		assert.False(t, d.IsZero())
+
+		data, err := json.Marshal(d)
+		assert.Nil(t, err)
+		assert.Equal(t, `"00:00"`, string(data))
+
+		data, err = json.Marshal(NewDuration(0))
+		assert.Nil(t, err)
+		assert.Equal(t, `"00:00"`, string(data))
+	})
+
+	t.Run("Test alternative formats", func(t *testing.T) {
+		var dt DateTime
+		assert.Nil(t, json.Unmarshal([]byte(`"2024-05-01T10:20:30Z"`), &dt))
+		assert.Equal(t, "2024-05-01 10:20:30", dt.String())
+
+		var tod TimeOfDay
+		assert.Nil(t, json.Unmarshal([]byte(`"14:30:00"`), &tod))
+		assert.Equal(t, "14:30", tod.String())
+
+		var d Duration
+		assert.Nil(t, json.Unmarshal([]byte(`"00:45:30"`), &d))
+		assert.Equal(t, 45*time.Minute+30*time.Second, d.Duration)
+	})
+
+	t.Run("Test invalid values", func(t *testing.T) {
+		var date Date
+		assert.EqualError(t, json.Unmarshal([]byte(`"01/06/2020"`), &date), `pipedrive: invalid date "01/06/2020"`)
+
+		var d Duration
+		assert.Error(t, json.Unmarshal([]byte(`"90"`), &d))
+	})
+}
@@ -0,0 +1,166 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users
+
+// User represents a Pipedrive user. Its ID lines up with UserID references.
+type User struct {
+	ID              int       `json:"id"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	HasPic          int       `json:"has_pic,omitempty"`
+	PicHash         *string   `json:"pic_hash,omitempty"`
+	ActiveFlag      bool      `json:"active_flag"`
+	Phone           *string   `json:"phone,omitempty"`
+	IconURL         *string   `json:"icon_url,omitempty"`
+	IsAdmin         int       `json:"is_admin,omitempty"`
+	IsYou           bool      `json:"is_you,omitempty"`
+	RoleID          int       `json:"role_id,omitempty"`
+	Activated       bool      `json:"activated,omitempty"`
+	DefaultCurrency string    `json:"default_currency,omitempty"`
+	Locale          string    `json:"locale,omitempty"`
+	Lang            int       `json:"lang,omitempty"`
+	TimezoneName    string    `json:"timezone_name,omitempty"`
+	TimezoneOffset  string    `json:"timezone_offset,omitempty"`
+	LastLogin       *DateTime `json:"last_login,omitempty"`
+	Created         *DateTime `json:"created,omitempty"`
+	Modified        *DateTime `json:"modified,omitempty"`
+}
+
+// Ref returns a reference to the user, e.g. to assign it as the owner of a deal.
+func (u *User) Ref() *UserID {
+	return &UserID{ID: u.ID, Name: u.Name, Email: u.Email, IsActive: u.ActiveFlag}
+}
+
+// CurrentUser is the authorized user, along with its company.
+type CurrentUser struct {
+	User
+
+	CompanyID      int    `json:"company_id,omitempty"`
+	CompanyName    string `json:"company_name,omitempty"`
+	CompanyDomain  string `json:"company_domain,omitempty"`
+	CompanyCountry string `json:"company_country,omitempty"`
+	Language       struct {
+		LanguageCode string `json:"language_code"`
+		CountryCode  string `json:"country_code"`
+	} `json:"language"`
+}
+
+// UserPermissions are the permissions of a user, such as
+// "can_delete_deals", keyed by name.
+type UserPermissions map[string]bool
+
+// Can reports whether the permission is granted. Unknown permissions aren't.
+func (p UserPermissions) Can(permission string) bool {
+	return p[permission]
+}
+
+// RoleAssignment is the assignment of a user to a role.
+type RoleAssignment struct {
+	UserID       int    `json:"user_id"`
+	RoleID       int    `json:"role_id"`
+	ParentRoleID *int   `json:"parent_role_id,omitempty"`
+	Name         string `json:"name"`
+	ActiveFlag   bool   `json:"active_flag"`
+	Type         string `json:"type,omitempty"`
+}
+
+// GetCurrentUser gets the authorized user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_me
+func (c *Client) GetCurrentUser(ctx context.Context) (*CurrentUser, error) {
+	user, _, err := doData[*CurrentUser](ctx, c, http.MethodGet, "/users/me", nil, nil)
+	return user, err
+}
+
+// ListUsers lists all users of the company.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	users, _, err := doData[[]User](ctx, c, http.MethodGet, "/users", nil, nil)
+	return users, err
+}
+
+// GetUser gets a user by ID.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id
+func (c *Client) GetUser(ctx context.Context, id int) (*User, error) {
+	user, _, err := doData[*User](ctx, c, http.MethodGet, fmt.Sprintf("/users/%v", id), nil, nil)
+	return user, err
+}
+
+// FindUsersOptions is used to configure a find users request. Term is required
+type FindUsersOptions struct {
+	Term          string `url:"term"`                          // The search term to look for (REQUIRED)
+	SearchByEmail *bool  `url:"search_by_email,omitempty,int"` // When enabled, the term will only be matched against email addresses of users
+}
+
+// FindUsers finds users by name, or by email if SearchByEmail is set.
+// Partial matches are returned as well.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_find
+func (c *Client) FindUsers(ctx context.Context, opt *FindUsersOptions) ([]User, error) {
+	users, _, err := doData[[]User](ctx, c, http.MethodGet, "/users/find", opt, nil)
+	return users, err
+}
+
+// FindUserByEmail finds the user with an email address, compared
+// case-insensitively. The error matches ErrNotFound if there's none.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	searchByEmail := true
+	users, err := c.FindUsers(ctx, &FindUsersOptions{Term: email, SearchByEmail: &searchByEmail})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			return &users[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: user with email %q", ErrNotFound, email)
+}
+
+// ListUserFollowers lists the IDs of the users following a user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_followers
+func (c *Client) ListUserFollowers(ctx context.Context, id int) ([]int, error) {
+	ids, _, err := doData[[]int](ctx, c, http.MethodGet, fmt.Sprintf("/users/%v/followers", id), nil, nil)
+	return ids, err
+}
+
+// GetUserPermissions gets the permissions of a user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_permissions
+func (c *Client) GetUserPermissions(ctx context.Context, id int) (UserPermissions, error) {
+	permissions, _, err := doData[UserPermissions](ctx, c, http.MethodGet, fmt.Sprintf("/users/%v/permissions", id), nil, nil)
+	return permissions, err
+}
+
+// ListUserRoleAssignmentsOptions is used to configure a list user role assignments request.
+type ListUserRoleAssignmentsOptions struct {
+	Start *int `url:"start,omitempty"` // Pagination start
+	Limit *int `url:"limit,omitempty"` // Items shown per page
+}
+
+// ListUserRoleAssignments lists the role assignments of a user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_roleAssignments
+func (c *Client) ListUserRoleAssignments(ctx context.Context, id int, opt *ListUserRoleAssignmentsOptions) (*ListResponse[RoleAssignment], error) {
+	uri := fmt.Sprintf("/users/%v/roleAssignments", id)
+	list, _, err := doList[RoleAssignment](ctx, c, http.MethodGet, uri, opt)
+	return list, err
+}
+
+// IterateUserRoleAssignments returns an Iterator over the role assignments of a user.
+func (c *Client) IterateUserRoleAssignments(id int, opt *ListUserRoleAssignmentsOptions) *Iterator[RoleAssignment] {
+	o := copyOptions(opt)
+	return listIterator[RoleAssignment](c, fmt.Sprintf("/users/%v/roleAssignments", id), &o.Start, o)
+}
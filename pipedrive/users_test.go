@@ -0,0 +1,139 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsers(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/me":
+			w.Write([]byte(`{"success":true,"data":{"id":11535881,"name":"Tom Shi","default_currency":"AUD","locale":"en_AU","lang":1,"email":"tom.shi@societyone.com.au","phone":null,"activated":true,"last_login":"2020-06-29 23:01:02","created":"2020-01-01 00:00:00","modified":"2020-06-29 23:01:02","has_created_company":true,"access":[{"app":"sales","admin":true,"permission_set_id":"1"}],"active_flag":true,"timezone_name":"Australia/Sydney","timezone_offset":"+10:00","role_id":1,"icon_url":null,"is_you":true,"is_admin":1,"company_id":7571105,"company_name":"SocietyOne","company_domain":"societyone","company_country":"AU","language":{"language_code":"en","country_code":"AU"}}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+			w.Write([]byte(`{"success":true,"data":[{"id":11535881,"name":"Tom Shi","email":"tom.shi@societyone.com.au","active_flag":true,"is_admin":1,"role_id":1},{"id":11535882,"name":"Jane Doe","email":"jane@societyone.com.au","active_flag":false,"is_admin":0,"role_id":2}]}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/11535882":
+			w.Write([]byte(`{"success":true,"data":{"id":11535882,"name":"Jane Doe","email":"jane@societyone.com.au","active_flag":false}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/find":
+			assert.Equal(t, "1", req.URL.Query().Get("search_by_email"))
+			if req.URL.Query().Get("term") == "JANE@societyone.com.au" {
+				w.Write([]byte(`{"success":true,"data":[{"id":11535883,"name":"Jane Roe","email":"jane.roe@societyone.com.au","active_flag":true},{"id":11535882,"name":"Jane Doe","email":"jane@societyone.com.au","active_flag":false}]}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"data":null}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/11535881/followers":
+			w.Write([]byte(`{"success":true,"data":[11535882,11535883]}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/11535882/permissions":
+			w.Write([]byte(`{"success":true,"data":{"can_add_custom_fields":false,"can_delete_deals":true,"can_see_other_users":true}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/11535882/roleAssignments":
+			w.Write([]byte(`{"success":true,"data":[{"user_id":11535882,"role_id":2,"parent_role_id":1,"name":"Sales","active_flag":true,"type":"2"}],"additional_data":{"pagination":{"start":0,"limit":100,"more_items_in_collection":false}}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test get current user", func(t *testing.T) {
+		me, err := testClient.GetCurrentUser(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 11535881, me.ID)
+		assert.True(t, me.IsYou)
+		assert.Equal(t, 1, me.IsAdmin)
+		assert.Equal(t, "Australia/Sydney", me.TimezoneName)
+		assert.Equal(t, 2020, me.LastLogin.Year())
+		assert.Equal(t, "societyone", me.CompanyDomain)
+		assert.Equal(t, "AU", me.Language.CountryCode)
+	})
+
+	t.Run("Test list users", func(t *testing.T) {
+		users, err := testClient.ListUsers(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, users, 2) {
+			assert.False(t, users[1].ActiveFlag)
+			assert.Equal(t, 2, users[1].RoleID)
+		}
+	})
+
+	t.Run("Test resolve deal owner", func(t *testing.T) {
+		deal := &BaseDealObject{}
+		assert.Nil(t, json.Unmarshal([]byte(`{"id":1,"user_id":{"id":11535882,"name":"Jane Doe","value":11535882}}`), deal))
+
+		owner, err := testClient.GetUser(context.Background(), deal.UserID.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "jane@societyone.com.au", owner.Email)
+
+		data, err := json.Marshal(&BaseDealObject{UserID: owner.Ref()})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"user_id":"11535882"}`, string(data))
+	})
+
+	t.Run("Test find user by email", func(t *testing.T) {
+		user, err := testClient.FindUserByEmail(context.Background(), "JANE@societyone.com.au")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 11535882, user.ID)
+
+		_, err = testClient.FindUserByEmail(context.Background(), "nobody@societyone.com.au")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("Test list user followers", func(t *testing.T) {
+		ids, err := testClient.ListUserFollowers(context.Background(), 11535881)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []int{11535882, 11535883}, ids)
+	})
+
+	t.Run("Test get user permissions", func(t *testing.T) {
+		permissions, err := testClient.GetUserPermissions(context.Background(), 11535882)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.True(t, permissions.Can("can_delete_deals"))
+		assert.False(t, permissions.Can("can_add_custom_fields"))
+		assert.False(t, permissions.Can("can_fly"))
+	})
+
+	t.Run("Test iterate user role assignments", func(t *testing.T) {
+		assignments, err := testClient.IterateUserRoleAssignments(11535882, nil).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, assignments, 1) {
+			assert.Equal(t, "Sales", assignments[0].Name)
+			assert.Equal(t, 1, *assignments[0].ParentRoleID)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Pipedrive API v2 docs: https://developers.pipedrive.com/docs/api/v2
+//
+// v2 endpoints differ from v1 in their payloads: custom fields are nested
+// under custom_fields, timestamps are ISO 8601, references are plain IDs and
+// lists are paginated with a cursor. Updates are PATCH requests, so nil fields
+// are left unchanged; see Nullable.
+
+// V2Resource is a collection available in the v2 API.
+type V2Resource string
+
+const (
+	V2Deals         V2Resource = "deals"
+	V2Persons       V2Resource = "persons"
+	V2Organizations V2Resource = "organizations"
+	V2Activities    V2Resource = "activities"
+	V2Products      V2Resource = "products"
+)
+
+// CustomFields holds custom field values keyed by field key.
+type CustomFields map[string]interface{}
+
+// DealV2 represents a deal in the v2 API.
+type DealV2 struct {
+	// Unsettable Fields
+	ID         int        `json:"id,omitempty"`
+	AddTime    *time.Time `json:"add_time,omitempty"`
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	IsDeleted  bool       `json:"is_deleted,omitempty"`
+
+	// Settable Fields
+	Title             *string        `json:"title,omitempty"`
+	OwnerID           *int           `json:"owner_id,omitempty"`
+	PersonID          *Nullable[int] `json:"person_id,omitempty"`
+	OrgID             *Nullable[int] `json:"org_id,omitempty"`
+	PipelineID        *int           `json:"pipeline_id,omitempty"`
+	StageID           *int           `json:"stage_id,omitempty"`
+	Value             *float64       `json:"value,omitempty"`
+	Currency          *string        `json:"currency,omitempty"`
+	Status            *DealStatus    `json:"status,omitempty"`
+	Probability       *Nullable[int] `json:"probability,omitempty"`
+	LostReason        *string        `json:"lost_reason,omitempty"`
+	VisibleTo         *VisibleTo     `json:"visible_to,omitempty"`
+	ExpectedCloseDate *Date          `json:"expected_close_date,omitempty"`
+	CustomFields      CustomFields   `json:"custom_fields,omitempty"`
+}
+
+// ContactV2 is an email address or phone number of a v2 person.
+type ContactV2 struct {
+	Label   string `json:"label,omitempty"`
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// PersonV2 represents a person in the v2 API.
+type PersonV2 struct {
+	// Unsettable Fields
+	ID         int        `json:"id,omitempty"`
+	AddTime    *time.Time `json:"add_time,omitempty"`
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	IsDeleted  bool       `json:"is_deleted,omitempty"`
+
+	// Settable Fields
+	Name         *string        `json:"name,omitempty"`
+	FirstName    *string        `json:"first_name,omitempty"`
+	LastName     *string        `json:"last_name,omitempty"`
+	OwnerID      *int           `json:"owner_id,omitempty"`
+	OrgID        *Nullable[int] `json:"org_id,omitempty"`
+	Emails       []ContactV2    `json:"emails,omitempty"`
+	Phones       []ContactV2    `json:"phones,omitempty"`
+	VisibleTo    *VisibleTo     `json:"visible_to,omitempty"`
+	CustomFields CustomFields   `json:"custom_fields,omitempty"`
+}
+
+// OrganizationV2 represents an organization in the v2 API.
+type OrganizationV2 struct {
+	// Unsettable Fields
+	ID         int        `json:"id,omitempty"`
+	AddTime    *time.Time `json:"add_time,omitempty"`
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	IsDeleted  bool       `json:"is_deleted,omitempty"`
+
+	// Settable Fields
+	Name         *string      `json:"name,omitempty"`
+	OwnerID      *int         `json:"owner_id,omitempty"`
+	VisibleTo    *VisibleTo   `json:"visible_to,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+}
+
+// ActivityV2 represents an activity in the v2 API.
+type ActivityV2 struct {
+	// Unsettable Fields
+	ID         int        `json:"id,omitempty"`
+	AddTime    *time.Time `json:"add_time,omitempty"`
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	IsDeleted  bool       `json:"is_deleted,omitempty"`
+
+	// Settable Fields
+	Subject  *string        `json:"subject,omitempty"`
+	Type     *string        `json:"type,omitempty"`
+	OwnerID  *int           `json:"owner_id,omitempty"`
+	DealID   *Nullable[int] `json:"deal_id,omitempty"`
+	PersonID *Nullable[int] `json:"person_id,omitempty"`
+	OrgID    *Nullable[int] `json:"org_id,omitempty"`
+	DueDate  *Date          `json:"due_date,omitempty"`
+	DueTime  *TimeOfDay     `json:"due_time,omitempty"`
+	Duration *Duration      `json:"duration,omitempty"`
+	Done     *bool          `json:"done,omitempty"`
+	Busy     *bool          `json:"busy,omitempty"`
+	Note     *string        `json:"note,omitempty"`
+}
+
+// ProductV2 represents a product in the v2 API.
+type ProductV2 struct {
+	// Unsettable Fields
+	ID         int        `json:"id,omitempty"`
+	AddTime    *time.Time `json:"add_time,omitempty"`
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	IsDeleted  bool       `json:"is_deleted,omitempty"`
+
+	// Settable Fields
+	Name         *string      `json:"name,omitempty"`
+	Code         *string      `json:"code,omitempty"`
+	Description  *string      `json:"description,omitempty"`
+	Unit         *string      `json:"unit,omitempty"`
+	Tax          *float64     `json:"tax,omitempty"`
+	OwnerID      *int         `json:"owner_id,omitempty"`
+	VisibleTo    *VisibleTo   `json:"visible_to,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+}
+
+// ListV2Options is used to configure a v2 list request.
+type ListV2Options struct {
+	FilterID      *int    `url:"filter_id,omitempty"`      // If supplied, only items matching the filter are returned
+	IDs           string  `url:"ids,omitempty"`            // Comma-separated IDs of the items to return
+	OwnerID       *int    `url:"owner_id,omitempty"`       // If supplied, only items owned by the user are returned
+	UpdatedSince  string  `url:"updated_since,omitempty"`  // RFC3339 timestamp. Only items updated at or after it are returned
+	UpdatedUntil  string  `url:"updated_until,omitempty"`  // RFC3339 timestamp. Only items updated before it are returned
+	SortBy        string  `url:"sort_by,omitempty"`        // id, update_time or add_time
+	SortDirection string  `url:"sort_direction,omitempty"` // asc or desc
+	IncludeFields string  `url:"include_fields,omitempty"` // Comma-separated optional fields to include
+	CustomFields  string  `url:"custom_fields,omitempty"`  // Comma-separated custom field keys to include
+	Limit         *int    `url:"limit,omitempty"`          // Items shown per page
+	Cursor        *string `url:"cursor,omitempty"`         // Set by the iterator
+}
+
+// cursorAdditionalData is the additional_data of v2 list responses.
+type cursorAdditionalData struct {
+	NextCursor string `json:"next_cursor"`
+}
+
+// GetV2 gets an item of a v2 collection by ID, decoded into T.
+func GetV2[T any](ctx context.Context, c *Client, resource V2Resource, id int) (T, *Response, error) {
+	return doDataV2[T](ctx, c, http.MethodGet, fmt.Sprintf("/%s/%v", resource, id), nil, nil)
+}
+
+// CreateV2 creates an item in a v2 collection and returns it decoded into T.
+func CreateV2[T any](ctx context.Context, c *Client, resource V2Resource, item interface{}) (T, *Response, error) {
+	return doDataV2[T](ctx, c, http.MethodPost, fmt.Sprintf("/%s", resource), nil, item)
+}
+
+// UpdateV2 patches an item of a v2 collection and returns it decoded into T.
+// Only the fields set on item are changed.
+func UpdateV2[T any](ctx context.Context, c *Client, resource V2Resource, id int, item interface{}) (T, *Response, error) {
+	return doDataV2[T](ctx, c, http.MethodPatch, fmt.Sprintf("/%s/%v", resource, id), nil, item)
+}
+
+// DeleteV2 deletes an item of a v2 collection.
+func DeleteV2(ctx context.Context, c *Client, resource V2Resource, id int) (*Response, error) {
+	req, err := c.NewVersionedRequest(APIVersion2, http.MethodDelete, fmt.Sprintf("/%s/%v", resource, id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return doRequest(ctx, c, req, &BaseResponse{})
+}
+
+// ListV2 returns an Iterator over a v2 collection, decoded into T. Pages are
+// fetched with the cursor returned by the previous page.
+func ListV2[T any](c *Client, resource V2Resource, opt *ListV2Options) *Iterator[T] {
+	o := copyOptions(opt)
+	return cursorListIterator[T](c, fmt.Sprintf("/%s", resource), &o.Cursor, o)
+}
+
+// cursorListIterator returns an Iterator over a cursor paginated v2 list,
+// decoded into T. cursor points to the Cursor field of opt, which is set to
+// the cursor of each page before it's fetched.
+func cursorListIterator[T any](c *Client, uri string, cursor **string, opt interface{}) *Iterator[T] {
+	return NewCursorIterator(func(ctx context.Context, next string) (*CursorPage[T], error) {
+		*cursor = nil
+		if next != "" {
+			*cursor = &next
+		}
+
+		req, err := c.NewVersionedRequest(APIVersion2, http.MethodGet, uri, opt, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		out := &ListResponse[T]{}
+		if _, err := doRequest(ctx, c, req, out); err != nil {
+			return nil, err
+		}
+
+		additional := cursorAdditionalData{}
+		if err := out.DecodeAdditionalData(&additional); err != nil {
+			return nil, err
+		}
+
+		return &CursorPage[T]{Items: out.Data, NextCursor: additional.NextCursor}, nil
+	})
+}
+
+// doDataV2 sends a request to a v2 endpoint and returns the typed data of the response.
+func doDataV2[T any](ctx context.Context, c *Client, method, uri string, opt interface{}, body interface{}) (T, *Response, error) {
+	var zero T
+
+	req, err := c.NewVersionedRequest(APIVersion2, method, uri, opt, body)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	out := &DataResponse[T]{}
+	resp, err := doRequest(ctx, c, req, out)
+	if err != nil {
+		return zero, resp, err
+	}
+
+	return out.Data, resp, nil
+}
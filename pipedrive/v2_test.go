@@ -0,0 +1,92 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestV2(t *testing.T) {
+	testAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v2/deals/5":
+			w.Write([]byte(`{"success":true,"data":{"id":5,"title":"Deal","owner_id":11,"person_id":3,"org_id":null,"add_time":"2024-05-01T10:20:30Z","custom_fields":{"f68bc64c61ed5be74939265930336b9424d7c39b":"custom"}}}`))
+
+		case req.Method == http.MethodPatch && req.URL.Path == "/api/v2/deals/5":
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.JSONEq(t, `{"title":"Renamed","custom_fields":{"f68bc64c61ed5be74939265930336b9424d7c39b":"changed"}}`, string(body))
+			w.Write([]byte(`{"success":true,"data":{"id":5,"title":"Renamed"}}`))
+
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v2/persons":
+			assert.Equal(t, "2", req.URL.Query().Get("limit"))
+			switch req.URL.Query().Get("cursor") {
+			case "":
+				w.Write([]byte(`{"success":true,"data":[{"id":1,"name":"One"},{"id":2,"name":"Two"}],"additional_data":{"next_cursor":"abc"}}`))
+			case "abc":
+				w.Write([]byte(`{"success":true,"data":[{"id":3,"name":"Three","emails":[{"value":"three@example.com","primary":true}]}],"additional_data":{"next_cursor":null}}`))
+			}
+
+		case req.Method == http.MethodDelete && req.URL.Path == "/api/v2/activities/7":
+			w.Write([]byte(`{"success":true,"data":{"id":7}}`))
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testAPI.Close()
+
+	testClient := NewClient(&Config{APIKey: "1", BaseURL: testAPI.URL})
+
+	t.Run("Test get deal", func(t *testing.T) {
+		deal, _, err := GetV2[DealV2](context.Background(), testClient, V2Deals, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "Deal", *deal.Title)
+		assert.Equal(t, 11, *deal.OwnerID)
+		assert.Nil(t, deal.OrgID)
+		assert.Equal(t, 2024, deal.AddTime.Year())
+		assert.Equal(t, "custom", deal.CustomFields["f68bc64c61ed5be74939265930336b9424d7c39b"])
+	})
+
+	t.Run("Test patch deal", func(t *testing.T) {
+		title := "Renamed"
+		deal, _, err := UpdateV2[DealV2](context.Background(), testClient, V2Deals, 5, &DealV2{
+			Title:        &title,
+			CustomFields: CustomFields{"f68bc64c61ed5be74939265930336b9424d7c39b": "changed"},
+		})
+		if assert.Nil(t, err) {
+			assert.Equal(t, "Renamed", *deal.Title)
+		}
+	})
+
+	t.Run("Test cursor pagination", func(t *testing.T) {
+		limit := 2
+		persons, err := ListV2[PersonV2](testClient, V2Persons, &ListV2Options{Limit: &limit}).All(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, persons, 3) {
+			assert.Equal(t, "Three", *persons[2].Name)
+			assert.Equal(t, "three@example.com", persons[2].Emails[0].Value)
+		}
+	})
+
+	t.Run("Test delete activity", func(t *testing.T) {
+		_, err := DeleteV2(context.Background(), testClient, V2Activities, 7)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test endpoint template", func(t *testing.T) {
+		req, _ := testClient.NewVersionedRequest(APIVersion2, http.MethodGet, "/deals/5", nil, nil)
+		assert.Equal(t, "/v2/deals/{id}", testClient.endpointTemplate(req.URL))
+	})
+}